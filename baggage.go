@@ -0,0 +1,119 @@
+package bind
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+// BaggageOption configures how baggage members are included in
+// measurements.
+type BaggageOption interface {
+	applyBaggage(baggageConfig) baggageConfig
+}
+
+type baggageOptionFunc func(baggageConfig) baggageConfig
+
+func (f baggageOptionFunc) applyBaggage(c baggageConfig) baggageConfig { return f(c) }
+
+type baggageConfig struct {
+	rename map[string]attribute.Key
+	limit  int
+}
+
+// WithBaggageAttributeKey returns a [BaggageOption] that uses key as the
+// attribute key for the baggage member with the member key. By default, the
+// member key is used as the attribute key.
+func WithBaggageAttributeKey(member string, key attribute.Key) BaggageOption {
+	return baggageOptionFunc(func(c baggageConfig) baggageConfig {
+		if c.rename == nil {
+			c.rename = make(map[string]attribute.Key)
+		}
+		c.rename[member] = key
+		return c
+	})
+}
+
+// WithBaggageValueLimit returns a [BaggageOption] that truncates baggage
+// member values to at most n bytes. Values are truncated at a UTF-8 character
+// boundary. If n is less than or equal to zero, values are not truncated.
+func WithBaggageValueLimit(n int) BaggageOption {
+	return baggageOptionFunc(func(c baggageConfig) baggageConfig {
+		c.limit = n
+		return c
+	})
+}
+
+// baggageMember is a baggage member included in measurements.
+type baggageMember struct {
+	key   string
+	attr  attribute.Key
+	limit int
+}
+
+// WithBaggage returns an [Option] that includes the members of the
+// measurement context's [baggage.Baggage] with keys in the keys allowlist as
+// attributes of all measurements. Members not in keys are ignored.
+//
+// Baggage attributes take precedence over bound attributes with the same key.
+// Span attributes (see [WithSpanAttributes]), context attributes (see
+// [WithContextAttributes]), and attributes passed when making a measurement
+// take precedence over baggage attributes.
+//
+// Multiple WithBaggage options can be used. The keys of all options are
+// included.
+func WithBaggage(keys []string, opts ...BaggageOption) Option {
+	var bc baggageConfig
+	for _, o := range opts {
+		bc = o.applyBaggage(bc)
+	}
+
+	members := make([]baggageMember, len(keys))
+	for i, k := range keys {
+		attr, ok := bc.rename[k]
+		if !ok {
+			attr = attribute.Key(k)
+		}
+		members[i] = baggageMember{key: k, attr: attr, limit: bc.limit}
+	}
+
+	return optionFunc(func(c config) config {
+		cp := make([]baggageMember, 0, len(c.baggage)+len(members))
+		cp = append(cp, c.baggage...)
+		c.baggage = append(cp, members...)
+		return c
+	})
+}
+
+// baggageAttributes returns the attributes for members found in the baggage
+// of ctx.
+func baggageAttributes(ctx context.Context, members []baggageMember) attribute.Set {
+	bag := baggage.FromContext(ctx)
+	if bag.Len() == 0 {
+		return *attribute.EmptySet()
+	}
+
+	var attrs []attribute.KeyValue
+	for _, m := range members {
+		member := bag.Member(m.key)
+		if member.Key() == "" {
+			continue
+		}
+		attrs = append(attrs, m.attr.String(truncate(member.Value(), m.limit)))
+	}
+	return attribute.NewSet(attrs...)
+}
+
+// truncate returns s truncated to at most n bytes without splitting a UTF-8
+// encoded character. If n is less than or equal to zero, s is returned.
+func truncate(s string, n int) string {
+	if n <= 0 || len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
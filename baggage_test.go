@@ -0,0 +1,120 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func contextWithBaggage(t *testing.T, kv ...string) context.Context {
+	t.Helper()
+
+	var members []baggage.Member
+	for i := 0; i < len(kv); i += 2 {
+		m, err := baggage.NewMemberRaw(kv[i], kv[i+1])
+		require.NoError(t, err)
+		members = append(members, m)
+	}
+	bag, err := baggage.New(members...)
+	require.NoError(t, err)
+	return baggage.ContextWithBaggage(context.Background(), bag)
+}
+
+func testBaggage[T any, N any](mock Mock[T, N], b Binder[T], m Measure[T, N], val N) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		inst := bind.Configure(
+			b(mock.Instrument(), userAlice),
+			bind.WithBaggage([]string{"tenant.id", "user"}),
+		)
+		ctx := contextWithBaggage(t, "tenant.id", "acme", "user", "bob", "secret", "value")
+
+		m(inst, ctx, val, nil)
+		_, got := mock.Recorded()
+		want := []attribute.KeyValue{
+			attribute.String("tenant.id", "acme"),
+			attribute.String("user", "bob"),
+		}
+		assert.ElementsMatch(t, want, got, "baggage attributes")
+
+		m(inst, context.Background(), val, nil)
+		_, got = mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice}, got, "no baggage")
+	}
+}
+
+func TestWithBaggage(t *testing.T) {
+	t.Run("Int64Counter", testBaggage(&mockInt64Counter{}, bind.Int64Counter, measInt64Counter, 1))
+	t.Run("Int64UpDownCounter", testBaggage(&mockInt64UpDownCounter{}, bind.Int64UpDownCounter, measInt64UpDownCounter, 1))
+	t.Run("Int64Histogram", testBaggage(&mockInt64Histogram{}, bind.Int64Histogram, measInt64Histogram, 1))
+	t.Run("Int64Gauge", testBaggage(&mockInt64Gauge{}, bind.Int64Gauge, measInt64Gauge, 1))
+	t.Run("Float64Counter", testBaggage(&mockFloat64Counter{}, bind.Float64Counter, measFloat64Counter, 1))
+	t.Run("Float64UpDownCounter", testBaggage(&mockFloat64UpDownCounter{}, bind.Float64UpDownCounter, measFloat64UpDownCounter, 1))
+	t.Run("Float64Histogram", testBaggage(&mockFloat64Histogram{}, bind.Float64Histogram, measFloat64Histogram, 1))
+	t.Run("Float64Gauge", testBaggage(&mockFloat64Gauge{}, bind.Float64Gauge, measFloat64Gauge, 1))
+}
+
+func TestWithBaggageOptions(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	inst := bind.Configure(
+		bind.Float64Counter(mock, userAlice),
+		bind.WithBaggage(
+			[]string{"tenant.id", "deployment.ring"},
+			bind.WithBaggageAttributeKey("deployment.ring", "ring"),
+			bind.WithBaggageValueLimit(4),
+		),
+		bind.WithBaggage([]string{"user"}),
+	)
+
+	ctx := contextWithBaggage(t, "tenant.id", "acme-corp", "deployment.ring", "1", "user", "bob")
+	inst.Add(ctx, 1)
+
+	_, got := mock.Recorded()
+	want := []attribute.KeyValue{
+		attribute.String("tenant.id", "acme"),
+		attribute.String("ring", "1"),
+		attribute.String("user", "bob"),
+	}
+	assert.ElementsMatch(t, want, got)
+}
+
+func TestWithBaggageValueLimitUTF8(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	inst := bind.Configure[metric.Float64Counter](
+		mock,
+		bind.WithBaggage([]string{"name"}, bind.WithBaggageValueLimit(2)),
+	)
+
+	// "é" is encoded with 2 bytes.
+	inst.Add(contextWithBaggage(t, "name", "aéb"), 1)
+
+	_, got := mock.Recorded()
+	assert.Equal(t, []attribute.KeyValue{attribute.String("name", "a")}, got)
+}
+
+func TestWithBaggagePrecedence(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	inst := bind.Configure(
+		bind.Float64Counter(mock, userAlice, userID),
+		bind.WithBaggage([]string{"user", "id"}),
+		bind.WithContextAttributes(),
+	)
+
+	ctx := contextWithBaggage(t, "user", "bob", "id", "1")
+	ctx = bind.ContextWithAttributes(ctx, attribute.String("id", "2"))
+	inst.Add(ctx, 1, metric.WithAttributes(attribute.String("user", "carol")))
+
+	_, got := mock.Recorded()
+	want := []attribute.KeyValue{
+		attribute.String("id", "2"),
+		attribute.String("user", "carol"),
+	}
+	assert.ElementsMatch(t, want, got)
+}
@@ -0,0 +1,61 @@
+package bind
+
+import (
+	"reflect"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Bind binds attrs to inst. It dispatches to the bind function of the type T
+// (e.g. [Float64Counter] for a [metric.Float64Counter]).
+//
+// T needs to be one of the following types, otherwise Bind panics:
+//
+//   - [metric.Int64Counter]
+//   - [metric.Int64UpDownCounter]
+//   - [metric.Int64Histogram]
+//   - [metric.Int64Gauge]
+//   - [metric.Float64Counter]
+//   - [metric.Float64UpDownCounter]
+//   - [metric.Float64Histogram]
+//   - [metric.Float64Gauge]
+//   - [metric.Meter]
+//   - [metric.MeterProvider]
+//   - [trace.Tracer]
+//   - [trace.TracerProvider]
+//
+// Dispatch is based on the type T, not the dynamic type of inst. A concrete
+// instrument type needs to be converted to its interface type first.
+func Bind[T any](inst T, attrs ...attribute.KeyValue) T {
+	switch p := any(&inst).(type) {
+	case *metric.Int64Counter:
+		*p = Int64Counter(*p, attrs...)
+	case *metric.Int64UpDownCounter:
+		*p = Int64UpDownCounter(*p, attrs...)
+	case *metric.Int64Histogram:
+		*p = Int64Histogram(*p, attrs...)
+	case *metric.Int64Gauge:
+		*p = Int64Gauge(*p, attrs...)
+	case *metric.Float64Counter:
+		*p = Float64Counter(*p, attrs...)
+	case *metric.Float64UpDownCounter:
+		*p = Float64UpDownCounter(*p, attrs...)
+	case *metric.Float64Histogram:
+		*p = Float64Histogram(*p, attrs...)
+	case *metric.Float64Gauge:
+		*p = Float64Gauge(*p, attrs...)
+	case *metric.Meter:
+		*p = Meter(*p, attrs...)
+	case *metric.MeterProvider:
+		*p = MeterProvider(*p, attrs...)
+	case *trace.Tracer:
+		*p = Tracer(*p, attrs...)
+	case *trace.TracerProvider:
+		*p = TracerProvider(*p, attrs...)
+	default:
+		panic("bind: unsupported type " + reflect.TypeFor[T]().String())
+	}
+	return inst
+}
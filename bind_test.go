@@ -4,9 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/MrAlias/bind"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
 var (
@@ -75,3 +77,40 @@ func testNoSideEffects[T any, N any](binder Binder[T], mock Mock[T, N]) func(*te
 		assert.Equal(t, cpB, b)
 	}
 }
+
+func testBind[T any](inst T) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		bound := bind.Bind(inst, userAlice)
+		bound = bind.Bind(bound, userID)
+
+		got, set := bind.Unwrap(bound)
+		assert.Equal(t, inst, got, "unwrapped")
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, set.ToSlice(), "bound attributes")
+	}
+}
+
+func TestBind(t *testing.T) {
+	t.Run("Int64Counter", testBind[metric.Int64Counter](&mockInt64Counter{}))
+	t.Run("Int64UpDownCounter", testBind[metric.Int64UpDownCounter](&mockInt64UpDownCounter{}))
+	t.Run("Int64Histogram", testBind[metric.Int64Histogram](&mockInt64Histogram{}))
+	t.Run("Int64Gauge", testBind[metric.Int64Gauge](&mockInt64Gauge{}))
+	t.Run("Float64Counter", testBind[metric.Float64Counter](&mockFloat64Counter{}))
+	t.Run("Float64UpDownCounter", testBind[metric.Float64UpDownCounter](&mockFloat64UpDownCounter{}))
+	t.Run("Float64Histogram", testBind[metric.Float64Histogram](&mockFloat64Histogram{}))
+	t.Run("Float64Gauge", testBind[metric.Float64Gauge](&mockFloat64Gauge{}))
+	t.Run("Meter", testBind[metric.Meter](&mockMeter{}))
+	t.Run("MeterProvider", testBind[metric.MeterProvider](&mockMeterProvider{}))
+}
+
+func TestBindEmptyAttrs(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	assert.Same(t, mock, bind.Bind[metric.Float64Counter](mock), "bound should be the same as the input")
+}
+
+func TestBindUnsupported(t *testing.T) {
+	assert.PanicsWithValue(t, "bind: unsupported type *bind_test.mockFloat64Counter", func() {
+		_ = bind.Bind(&mockFloat64Counter{}, userAlice)
+	})
+}
@@ -0,0 +1,213 @@
+package bindgrpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/MrAlias/bind/bindgrpc"
+	"github.com/MrAlias/bind/bindtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+type meterProvider struct {
+	noop.MeterProvider
+
+	meter *bindtest.Meter
+}
+
+func newMeterProvider() *meterProvider {
+	return &meterProvider{meter: bindtest.NewMeter()}
+}
+
+func (p *meterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return p.meter
+}
+
+// newClient returns a health client connected over bufconn to a health
+// server. The server and client record their metrics with srvMP and cliMP.
+func newClient(t *testing.T, srvMP, cliMP metric.MeterProvider) (healthpb.HealthClient, *health.Server) {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(bindgrpc.UnaryServerInterceptor(bindgrpc.WithMeterProvider(srvMP))),
+		grpc.ChainStreamInterceptor(bindgrpc.StreamServerInterceptor(bindgrpc.WithMeterProvider(srvMP))),
+	)
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(srv, hs)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(bindgrpc.UnaryClientInterceptor(
+			bindgrpc.WithMeterProvider(cliMP),
+			bindgrpc.WithAttributes(attribute.String("peer.service", "health")),
+		)),
+		grpc.WithChainStreamInterceptor(bindgrpc.StreamClientInterceptor(
+			bindgrpc.WithMeterProvider(cliMP),
+			bindgrpc.WithAttributes(attribute.String("peer.service", "health")),
+		)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return healthpb.NewHealthClient(conn), hs
+}
+
+func methodAttrs(method, code string, extra ...attribute.KeyValue) attribute.Set {
+	attrs := append([]attribute.KeyValue{
+		attribute.String("rpc.system.name", "grpc"),
+		attribute.String("rpc.method", "grpc.health.v1.Health/"+method),
+		attribute.String("rpc.response.status_code", code),
+	}, extra...)
+	return attribute.NewSet(attrs...)
+}
+
+func values(m []bindtest.Measurement[int64]) []int64 {
+	out := make([]int64, len(m))
+	for i := range m {
+		out[i] = m[i].Value
+	}
+	return out
+}
+
+func TestUnary(t *testing.T) {
+	srvMP, cliMP := newMeterProvider(), newMeterProvider()
+	client, _ := newClient(t, srvMP, cliMP)
+
+	req := &healthpb.HealthCheckRequest{}
+	resp, err := client.Check(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+
+	_, err = client.Check(t.Context(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	peer := attribute.String("peer.service", "health")
+	for _, tc := range []struct {
+		side     string
+		mp       *meterProvider
+		extra    []attribute.KeyValue
+		notFound []attribute.KeyValue
+	}{
+		// NOT_FOUND is only an error for clients.
+		{side: "server", mp: srvMP},
+		{
+			side:     "client",
+			mp:       cliMP,
+			extra:    []attribute.KeyValue{peer},
+			notFound: []attribute.KeyValue{peer, attribute.String("error.type", "NOT_FOUND")},
+		},
+	} {
+		t.Run(tc.side, func(t *testing.T) {
+			m := tc.mp.meter
+			ok := methodAttrs("Check", "OK", tc.extra...)
+			notFound := methodAttrs("Check", "NOT_FOUND", tc.notFound...)
+
+			dur := m.Float64Measurements("rpc." + tc.side + ".call.duration")
+			require.Len(t, dur, 2)
+			assert.Equal(t, ok, dur[0].Attributes)
+			assert.Equal(t, notFound, dur[1].Attributes)
+
+			assert.True(t, bindtest.AssertMeasurements(t, []bindtest.Measurement[int64]{
+				{Value: 1, Attributes: ok},
+				{Value: 1, Attributes: notFound},
+			}, m.Int64Measurements("rpc."+tc.side+".requests_per_rpc")))
+			assert.True(t, bindtest.AssertMeasurements(t, []bindtest.Measurement[int64]{
+				{Value: 1, Attributes: ok},
+				{Value: 0, Attributes: notFound},
+			}, m.Int64Measurements("rpc."+tc.side+".responses_per_rpc")))
+
+			want := []int64{int64(proto.Size(req)), int64(proto.Size(&healthpb.HealthCheckRequest{Service: "unknown"}))}
+			assert.Equal(t, want, values(m.Int64Measurements("rpc."+tc.side+".request.size")))
+			assert.Equal(t, []int64{int64(proto.Size(resp))}, values(m.Int64Measurements("rpc."+tc.side+".response.size")))
+		})
+	}
+}
+
+func TestStream(t *testing.T) {
+	srvMP, cliMP := newMeterProvider(), newMeterProvider()
+	client, hs := newClient(t, srvMP, cliMP)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.GetStatus())
+
+	cancel()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	want := methodAttrs("Watch", "CANCELLED",
+		attribute.String("peer.service", "health"),
+		attribute.String("error.type", "CANCELLED"),
+	)
+	m := cliMP.meter
+	dur := m.Float64Measurements("rpc.client.call.duration")
+	require.Len(t, dur, 1)
+	assert.Equal(t, want, dur[0].Attributes)
+	assert.True(t, bindtest.AssertMeasurements(t, []bindtest.Measurement[int64]{
+		{Value: 1, Attributes: want},
+	}, m.Int64Measurements("rpc.client.requests_per_rpc")))
+	assert.True(t, bindtest.AssertMeasurements(t, []bindtest.Measurement[int64]{
+		{Value: 2, Attributes: want},
+	}, m.Int64Measurements("rpc.client.responses_per_rpc")))
+	assert.Len(t, m.Int64Measurements("rpc.client.response.size"), 2)
+
+	// The server handler returns after the client canceled the call.
+	assert.Eventually(t, func() bool {
+		return len(srvMP.meter.Float64Measurements("rpc.server.call.duration")) == 1
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []int64{2}, values(srvMP.meter.Int64Measurements("rpc.server.responses_per_rpc")))
+}
+
+func TestMaxMethods(t *testing.T) {
+	mp := newMeterProvider()
+	intercept := bindgrpc.UnaryServerInterceptor(bindgrpc.WithMeterProvider(mp), bindgrpc.WithMaxMethods(1))
+	handler := func(context.Context, any) (any, error) { return nil, nil }
+
+	for _, method := range []string{"/pkg.Service/Known", "/pkg.Service/Unknown", "/pkg.Service/Known"} {
+		_, err := intercept(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		require.NoError(t, err)
+	}
+
+	known := attribute.NewSet(
+		attribute.String("rpc.system.name", "grpc"),
+		attribute.String("rpc.method", "pkg.Service/Known"),
+		attribute.String("rpc.response.status_code", "OK"),
+	)
+	other := attribute.NewSet(
+		attribute.String("rpc.system.name", "grpc"),
+		attribute.String("rpc.method", "_OTHER"),
+		attribute.String("rpc.response.status_code", "OK"),
+	)
+	dur := mp.meter.Float64Measurements("rpc.server.call.duration")
+	require.Len(t, dur, 3)
+	assert.Equal(t, []attribute.Set{known, other, known}, []attribute.Set{dur[0].Attributes, dur[1].Attributes, dur[2].Attributes})
+}
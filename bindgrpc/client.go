@@ -0,0 +1,139 @@
+package bindgrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryClientInterceptor returns a [grpc.UnaryClientInterceptor] that records
+// RPC client metrics of unary calls.
+func UnaryClientInterceptor(opts ...Option) grpc.UnaryClientInterceptor {
+	m := newMethods(opts, "client")
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		insts := m.get(method)
+		if n, ok := size(req); ok {
+			insts.RequestSize.Record(ctx, n)
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		var responses int64
+		if err == nil {
+			responses = 1
+			if n, ok := size(reply); ok {
+				insts.ResponseSize.Record(ctx, n)
+			}
+		}
+		o := m.status.option(err)
+		insts.Duration.Record(ctx, time.Since(start).Seconds(), o)
+		insts.RequestsPerRPC.Record(ctx, 1, o)
+		insts.ResponsesPerRPC.Record(ctx, responses, o)
+		return err
+	}
+}
+
+// StreamClientInterceptor returns a [grpc.StreamClientInterceptor] that
+// records RPC client metrics of streaming calls.
+//
+// A call is complete, and its duration and message counts are recorded, once
+// RecvMsg of the returned stream returns an error (including [io.EOF]) or
+// receives the response of a call without server streaming. Calls that are
+// never completed this way, e.g. by canceling their context without
+// receiving, are not recorded.
+func StreamClientInterceptor(opts ...Option) grpc.StreamClientInterceptor {
+	m := newMethods(opts, "client")
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		s := &clientStream{
+			ctx:           ctx,
+			start:         time.Now(),
+			insts:         m.get(method),
+			status:        m.status,
+			serverStreams: desc.ServerStreams,
+		}
+
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			s.finish(err)
+			return nil, err
+		}
+		s.ClientStream = cs
+		return s, nil
+	}
+}
+
+// clientStream counts and records the sizes of the messages of a client
+// stream and records the call once it completes.
+type clientStream struct {
+	grpc.ClientStream
+
+	ctx           context.Context
+	start         time.Time
+	insts         instruments
+	status        *statusOptions
+	serverStreams bool
+
+	// requests and responses are the number of sent and received messages.
+	// A stream can send and receive messages from different goroutines, so
+	// they are guarded by mu.
+	mu                  sync.Mutex
+	requests, responses int64
+	done                bool
+}
+
+func (s *clientStream) SendMsg(m any) error {
+	err := s.ClientStream.SendMsg(m)
+	if err == nil {
+		s.mu.Lock()
+		s.requests++
+		s.mu.Unlock()
+		if n, ok := size(m); ok {
+			s.insts.RequestSize.Record(s.ctx, n)
+		}
+	}
+	return err
+}
+
+func (s *clientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case err == nil:
+		s.mu.Lock()
+		s.responses++
+		s.mu.Unlock()
+		if n, ok := size(m); ok {
+			s.insts.ResponseSize.Record(s.ctx, n)
+		}
+		if !s.serverStreams {
+			s.finish(nil)
+		}
+	case errors.Is(err, io.EOF):
+		s.finish(nil)
+	default:
+		s.finish(err)
+	}
+	return err
+}
+
+// finish records the completed call with the status of err. Only the first
+// call to finish is recorded.
+func (s *clientStream) finish(err error) {
+	s.mu.Lock()
+	if s.done {
+		s.mu.Unlock()
+		return
+	}
+	s.done = true
+	requests, responses := s.requests, s.responses
+	s.mu.Unlock()
+
+	o := s.status.option(err)
+	s.insts.Duration.Record(s.ctx, time.Since(s.start).Seconds(), o)
+	s.insts.RequestsPerRPC.Record(s.ctx, requests, o)
+	s.insts.ResponsesPerRPC.Record(s.ctx, responses, o)
+}
@@ -0,0 +1,57 @@
+package bindgrpc
+
+import (
+	"github.com/MrAlias/bind/internal/instconfig"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ScopeName is the instrumentation scope name of the Meter used to create
+// instruments.
+const ScopeName = "github.com/MrAlias/bind/bindgrpc"
+
+// defaultMaxMethods is the default number of methods an interceptor binds
+// instruments to.
+const defaultMaxMethods = 1024
+
+// Option configures an interceptor.
+type Option interface {
+	instconfig.Option[config]
+}
+
+type config struct {
+	instconfig.Config
+
+	maxMethods int
+}
+
+func newConfig(opts []Option) config {
+	return instconfig.New(config{maxMethods: defaultMaxMethods}, opts)
+}
+
+// WithMeterProvider returns an [Option] that uses mp to create instruments.
+// By default, the global MeterProvider is used.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return instconfig.WithMeterProvider[config](mp)
+}
+
+// WithAttributes returns an [Option] that binds attrs to all instruments in
+// addition to the attributes of the called method.
+func WithAttributes(attrs ...attribute.KeyValue) Option {
+	return instconfig.WithAttributes[config](attrs)
+}
+
+// WithMaxMethods returns an [Option] that limits the number of methods an
+// interceptor binds instruments to to n. Calls of any other method are
+// recorded with an rpc.method of "_OTHER" and no rpc.service. This bounds the
+// number of attribute sets when clients call methods unknown to a server
+// (e.g. handled by [google.golang.org/grpc.UnknownServiceHandler]). If n is
+// less than or equal to zero, the default of 1024 is used.
+func WithMaxMethods(n int) Option {
+	return instconfig.OptionFunc[config](func(c *config) {
+		if n <= 0 {
+			n = defaultMaxMethods
+		}
+		c.maxMethods = n
+	})
+}
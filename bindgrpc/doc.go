@@ -0,0 +1,38 @@
+/*
+Package bindgrpc provides [google.golang.org/grpc] interceptors that record
+RPC metrics using bound instruments.
+
+The instruments of a method are bound to the rpc.system.name and rpc.method
+attributes of its full method name the first time the method is called and
+reused for all following calls. Only the rpc.response.status_code, and the
+error.type of failed calls, is added when a call completes, so no attributes
+are allocated per call. The number of methods bound is limited (see
+[WithMaxMethods]).
+
+Example usage:
+
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(bindgrpc.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(bindgrpc.StreamServerInterceptor()),
+	)
+
+	conn, err := grpc.NewClient(target,
+		grpc.WithChainUnaryInterceptor(bindgrpc.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(bindgrpc.StreamClientInterceptor()),
+	)
+
+The rpc.{side}.call.duration metric of the OpenTelemetry semantic
+conventions v1.41.0 is recorded, where side is "server" or "client". The
+following metrics, which are no longer part of those conventions, are
+recorded with the same attributes:
+
+  - rpc.{side}.request.size
+  - rpc.{side}.response.size
+  - rpc.{side}.requests_per_rpc
+  - rpc.{side}.responses_per_rpc
+
+Message sizes are recorded when a message is sent or received and are
+computed for protocol buffer messages only. Durations and message counts are
+recorded when a call completes and include its status code.
+*/
+package bindgrpc
@@ -0,0 +1,141 @@
+package bindgrpc
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/MrAlias/bind"
+	"github.com/MrAlias/bind/internal/instconfig"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"google.golang.org/protobuf/proto"
+)
+
+// durationBoundaries are the explicit bucket boundaries advised by the
+// semantic conventions for rpc.{side}.call.duration.
+var durationBoundaries = []float64{
+	0.005, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2.5, 5, 7.5, 10,
+}
+
+// instruments are the instruments of an interceptor.
+type instruments struct {
+	Duration        metric.Float64Histogram
+	RequestSize     metric.Int64Histogram
+	ResponseSize    metric.Int64Histogram
+	RequestsPerRPC  metric.Int64Histogram
+	ResponsesPerRPC metric.Int64Histogram
+}
+
+// newInstruments returns the instruments of side, either "server" or
+// "client", created with the Meter of c.
+func newInstruments(c config, side string) instruments {
+	m := c.Meter(ScopeName, metric.WithSchemaURL(semconv.SchemaURL))
+	return instruments{
+		Duration: instconfig.Float64Histogram(m,
+			"rpc."+side+".call.duration",
+			metric.WithUnit("s"),
+			metric.WithDescription("Duration of RPCs."),
+			metric.WithExplicitBucketBoundaries(durationBoundaries...),
+		),
+		RequestSize: instconfig.Int64Histogram(m,
+			"rpc."+side+".request.size",
+			metric.WithUnit("By"),
+			metric.WithDescription("Size of RPC request messages."),
+		),
+		ResponseSize: instconfig.Int64Histogram(m,
+			"rpc."+side+".response.size",
+			metric.WithUnit("By"),
+			metric.WithDescription("Size of RPC response messages."),
+		),
+		RequestsPerRPC: instconfig.Int64Histogram(m,
+			"rpc."+side+".requests_per_rpc",
+			metric.WithUnit("{count}"),
+			metric.WithDescription("Number of request messages per RPC."),
+		),
+		ResponsesPerRPC: instconfig.Int64Histogram(m,
+			"rpc."+side+".responses_per_rpc",
+			metric.WithUnit("{count}"),
+			metric.WithDescription("Number of response messages per RPC."),
+		),
+	}
+}
+
+// otherMethod is the rpc.method of methods that are not valid full method
+// names or are called after the limit of bound methods is reached.
+const otherMethod = "_OTHER"
+
+// methods are the instruments of an interceptor bound to each method.
+type methods struct {
+	insts  instruments
+	status *statusOptions
+	// other are the instruments of methods called after max methods are
+	// bound.
+	other instruments
+	max   int
+
+	bound sync.Map // map[string]instruments
+
+	mu sync.Mutex
+	n  int
+}
+
+func newMethods(opts []Option, side string) *methods {
+	c := newConfig(opts)
+	insts := newInstruments(c, side)
+	status := clientStatus
+	if side == "server" {
+		status = serverStatus
+	}
+	return &methods{
+		insts:  insts,
+		status: status,
+		other:  bind.Instruments(insts, semconv.RPCSystemNameGRPC, semconv.RPCMethod(otherMethod)),
+		max:    c.maxMethods,
+	}
+}
+
+// get returns the instruments bound to the full method name fullMethod. If
+// max methods are already bound, the instruments of otherMethod are returned
+// for any other method.
+func (m *methods) get(fullMethod string) instruments {
+	if insts, ok := m.bound.Load(fullMethod); ok {
+		return insts.(instruments)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if insts, ok := m.bound.Load(fullMethod); ok {
+		// Added concurrently.
+		return insts.(instruments)
+	}
+	if m.n >= m.max {
+		return m.other
+	}
+	insts := bind.Instruments(m.insts, methodAttrs(fullMethod)...)
+	m.bound.Store(fullMethod, insts)
+	m.n++
+	return insts
+}
+
+// methodAttrs returns the attributes of the full method name fullMethod
+// (e.g. "/grpc.health.v1.Health/Check").
+func methodAttrs(fullMethod string) []attribute.KeyValue {
+	method := strings.TrimPrefix(fullMethod, "/")
+	if service, name, ok := strings.Cut(method, "/"); !ok || service == "" || name == "" {
+		// Not a valid full method name.
+		method = otherMethod
+	}
+	return []attribute.KeyValue{semconv.RPCSystemNameGRPC, semconv.RPCMethod(method)}
+}
+
+// size returns the size of the protocol buffer message msg. If msg is not a
+// protocol buffer message, false is returned.
+func size(msg any) (int64, bool) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return 0, false
+	}
+	return int64(proto.Size(m)), true
+}
@@ -0,0 +1,89 @@
+package bindgrpc
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor returns a [grpc.UnaryServerInterceptor] that records
+// RPC server metrics of unary calls.
+func UnaryServerInterceptor(opts ...Option) grpc.UnaryServerInterceptor {
+	m := newMethods(opts, "server")
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		insts := m.get(info.FullMethod)
+		if n, ok := size(req); ok {
+			insts.RequestSize.Record(ctx, n)
+		}
+
+		resp, err := handler(ctx, req)
+
+		var responses int64
+		if err == nil {
+			responses = 1
+			if n, ok := size(resp); ok {
+				insts.ResponseSize.Record(ctx, n)
+			}
+		}
+		o := m.status.option(err)
+		insts.Duration.Record(ctx, time.Since(start).Seconds(), o)
+		insts.RequestsPerRPC.Record(ctx, 1, o)
+		insts.ResponsesPerRPC.Record(ctx, responses, o)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns a [grpc.StreamServerInterceptor] that
+// records RPC server metrics of streaming calls.
+func StreamServerInterceptor(opts ...Option) grpc.StreamServerInterceptor {
+	m := newMethods(opts, "server")
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		s := &serverStream{ServerStream: ss, insts: m.get(info.FullMethod)}
+
+		err := handler(srv, s)
+
+		ctx := ss.Context()
+		o := m.status.option(err)
+		s.insts.Duration.Record(ctx, time.Since(start).Seconds(), o)
+		s.insts.RequestsPerRPC.Record(ctx, s.requests.Load(), o)
+		s.insts.ResponsesPerRPC.Record(ctx, s.responses.Load(), o)
+		return err
+	}
+}
+
+// serverStream counts and records the sizes of the messages of a server
+// stream.
+type serverStream struct {
+	grpc.ServerStream
+
+	insts instruments
+	// requests and responses are the number of received and sent messages.
+	// A stream can send and receive messages from different goroutines.
+	requests, responses atomic.Int64
+}
+
+func (s *serverStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.requests.Add(1)
+		if n, ok := size(m); ok {
+			s.insts.RequestSize.Record(s.Context(), n)
+		}
+	}
+	return err
+}
+
+func (s *serverStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.responses.Add(1)
+		if n, ok := size(m); ok {
+			s.insts.ResponseSize.Record(s.Context(), n)
+		}
+	}
+	return err
+}
@@ -0,0 +1,91 @@
+package bindgrpc
+
+import (
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// codeNames are the rpc.response.status_code values of the gRPC status
+// codes.
+var codeNames = [...]string{
+	codes.OK:                 "OK",
+	codes.Canceled:           "CANCELLED",
+	codes.Unknown:            "UNKNOWN",
+	codes.InvalidArgument:    "INVALID_ARGUMENT",
+	codes.DeadlineExceeded:   "DEADLINE_EXCEEDED",
+	codes.NotFound:           "NOT_FOUND",
+	codes.AlreadyExists:      "ALREADY_EXISTS",
+	codes.PermissionDenied:   "PERMISSION_DENIED",
+	codes.ResourceExhausted:  "RESOURCE_EXHAUSTED",
+	codes.FailedPrecondition: "FAILED_PRECONDITION",
+	codes.Aborted:            "ABORTED",
+	codes.OutOfRange:         "OUT_OF_RANGE",
+	codes.Unimplemented:      "UNIMPLEMENTED",
+	codes.Internal:           "INTERNAL",
+	codes.Unavailable:        "UNAVAILABLE",
+	codes.DataLoss:           "DATA_LOSS",
+	codes.Unauthenticated:    "UNAUTHENTICATED",
+}
+
+// codeName returns the rpc.response.status_code value of c.
+func codeName(c codes.Code) string {
+	if int(c) < len(codeNames) {
+		return codeNames[c]
+	}
+	return strconv.FormatUint(uint64(c), 10)
+}
+
+// statusOptions are the measurement options that add the status code of a
+// call, and its error.type if the status code is an error, to a measurement.
+type statusOptions struct {
+	isError func(codes.Code) bool
+	opts    [len(codeNames)]metric.MeasurementOption
+}
+
+func newStatusOptions(isError func(codes.Code) bool) *statusOptions {
+	s := &statusOptions{isError: isError}
+	for c := range s.opts {
+		s.opts[c] = s.newOption(codes.Code(c))
+	}
+	return s
+}
+
+var (
+	// serverStatus are the status options of servers. Only status codes that
+	// indicate a server failure are errors.
+	serverStatus = newStatusOptions(func(c codes.Code) bool {
+		switch c {
+		case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented,
+			codes.Internal, codes.Unavailable, codes.DataLoss:
+			return true
+		default:
+			return false
+		}
+	})
+	// clientStatus are the status options of clients. All status codes
+	// other than OK are errors.
+	clientStatus = newStatusOptions(func(c codes.Code) bool { return c != codes.OK })
+)
+
+// option returns the measurement option for the status of err.
+func (s *statusOptions) option(err error) metric.MeasurementOption {
+	c := status.Code(err)
+	if int(c) < len(s.opts) {
+		return s.opts[c]
+	}
+	return s.newOption(c)
+}
+
+func (s *statusOptions) newOption(c codes.Code) metric.MeasurementOption {
+	name := codeName(c)
+	attrs := []attribute.KeyValue{semconv.RPCResponseStatusCode(name)}
+	if s.isError(c) {
+		attrs = append(attrs, semconv.ErrorTypeKey.String(name))
+	}
+	return metric.WithAttributeSet(attribute.NewSet(attrs...))
+}
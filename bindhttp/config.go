@@ -0,0 +1,58 @@
+package bindhttp
+
+import (
+	"github.com/MrAlias/bind/bindsemconv"
+	"github.com/MrAlias/bind/internal/instconfig"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ScopeName is the instrumentation scope name of the Meter used to create
+// instruments.
+const ScopeName = "github.com/MrAlias/bind/bindhttp"
+
+// Option configures a [Handler], [ServeMux], or [Transport].
+type Option interface {
+	instconfig.Option[config]
+}
+
+type config struct {
+	instconfig.Config
+
+	maxPeers int
+}
+
+func newConfig(opts []Option) config {
+	return instconfig.New(config{maxPeers: defaultMaxPeers}, opts)
+}
+
+// meter returns the Meter instruments are created with.
+func (c *config) meter() metric.Meter {
+	return c.Meter(ScopeName, metric.WithSchemaURL(bindsemconv.SchemaURL))
+}
+
+// WithMeterProvider returns an [Option] that uses mp to create instruments.
+// By default, the global MeterProvider is used.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return instconfig.WithMeterProvider[config](mp)
+}
+
+// WithAttributes returns an [Option] that binds attrs to all instruments in
+// addition to the attributes of a request.
+func WithAttributes(attrs ...attribute.KeyValue) Option {
+	return instconfig.WithAttributes[config](attrs)
+}
+
+// WithMaxPeers returns an [Option] that limits the number of peers a
+// [Transport] caches bound instruments for to n. When the limit is reached,
+// the instruments of the least recently used peer are evicted. If n is less
+// than or equal to zero, the default of 1024 is used. Handlers ignore this
+// option.
+func WithMaxPeers(n int) Option {
+	return instconfig.OptionFunc[config](func(c *config) {
+		if n <= 0 {
+			n = defaultMaxPeers
+		}
+		c.maxPeers = n
+	})
+}
@@ -0,0 +1,42 @@
+/*
+Package bindhttp provides [net/http] middleware and transports that record
+HTTP server and client metrics using bound instruments.
+
+The instruments of a handler are bound to the http.route and
+http.request.method attributes of the pattern it is registered with, and to
+each url.scheme, once, when the handler is created. Only the response status
+code is added when a measurement is made. Following the semantic conventions,
+http.server.active_requests is not bound to the http.route.
+
+Handlers forward the [http.Flusher], [http.Hijacker], and [io.ReaderFrom]
+interfaces of the wrapped [http.ResponseWriter]. Metrics are recorded even if
+the wrapped handler panics.
+
+Example usage:
+
+	mux := bindhttp.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", getUser)
+	http.ListenAndServe(":8080", mux)
+
+The following metrics of the OpenTelemetry semantic conventions (see
+[bindsemconv.Version]) are recorded:
+
+  - http.server.request.duration
+  - http.server.request.body.size
+  - http.server.response.body.size
+  - http.server.active_requests
+
+A [Transport] records HTTP client metrics. Its instruments are bound to the
+method and server address of a request the first time a request to that
+server is sent, and are reused for following requests. The instruments of a
+limited number of servers are kept (see [WithMaxPeers]):
+
+	client := &http.Client{Transport: bindhttp.NewTransport(nil)}
+
+The following client metrics are recorded:
+
+  - http.client.request.duration
+  - http.client.request.body.size
+  - http.client.response.body.size
+*/
+package bindhttp
@@ -0,0 +1,21 @@
+package bindhttp_test
+
+import (
+	"net/http"
+
+	"github.com/MrAlias/bind/bindhttp"
+)
+
+func Example() {
+	mux := bindhttp.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.PathValue("id")))
+	})
+
+	// Handlers can also be wrapped directly.
+	http.Handle("GET /health", bindhttp.Handler("GET /health", http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) },
+	)))
+
+	_ = http.ListenAndServe(":8080", mux)
+}
@@ -0,0 +1,177 @@
+package bindhttp
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MrAlias/bind"
+	"github.com/MrAlias/bind/bindsemconv"
+	"github.com/MrAlias/bind/internal/instconfig"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+)
+
+// durationBoundaries are the explicit bucket boundaries advised by the
+// semantic conventions for http.server.request.duration.
+var durationBoundaries = []float64{
+	0.005, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2.5, 5, 7.5, 10,
+}
+
+// instruments are the instruments of a handler.
+type instruments struct {
+	Duration     metric.Float64Histogram
+	RequestSize  metric.Int64Histogram
+	ResponseSize metric.Int64Histogram
+	Active       metric.Int64UpDownCounter
+}
+
+func newInstruments(m metric.Meter) instruments {
+	return instruments{
+		Duration: instconfig.Float64Histogram(m,
+			"http.server.request.duration",
+			metric.WithUnit("s"),
+			metric.WithDescription("Duration of HTTP server requests."),
+			metric.WithExplicitBucketBoundaries(durationBoundaries...),
+		),
+		RequestSize: instconfig.Int64Histogram(m,
+			"http.server.request.body.size",
+			metric.WithUnit("By"),
+			metric.WithDescription("Size of HTTP server request bodies."),
+		),
+		ResponseSize: instconfig.Int64Histogram(m,
+			"http.server.response.body.size",
+			metric.WithUnit("By"),
+			metric.WithDescription("Size of HTTP server response bodies."),
+		),
+		Active: instconfig.Int64UpDownCounter(m,
+			"http.server.active_requests",
+			metric.WithUnit("{request}"),
+			metric.WithDescription("Number of active HTTP server requests."),
+		),
+	}
+}
+
+// Handler returns h wrapped to record HTTP server metrics for the requests it
+// handles. The instruments are bound to the http.request.method of pattern, a
+// [http.ServeMux] pattern h is registered with (e.g. "GET /users/{id}"). All
+// instruments except http.server.active_requests are also bound to the
+// http.route of pattern.
+//
+// If pattern does not include a method, instruments are bound to the method
+// of each request the first time it is handled.
+//
+// The url.scheme of a request is "https" if it was received over TLS and
+// "http" otherwise. Instruments are bound to both schemes when the handler is
+// created.
+func Handler(pattern string, h http.Handler, opts ...Option) http.Handler {
+	c := newConfig(opts)
+	meter := c.meter()
+
+	method, route := parsePattern(pattern)
+	if method != "" {
+		meter = bind.Meter(meter, bindsemconv.HTTPMethod(method))
+	}
+
+	insts := newInstruments(meter)
+	if route != "" {
+		// The route is not a recommended attribute of active requests.
+		r := semconv.HTTPRoute(route)
+		insts.Duration = bind.Float64Histogram(insts.Duration, r)
+		insts.RequestSize = bind.Int64Histogram(insts.RequestSize, r)
+		insts.ResponseSize = bind.Int64Histogram(insts.ResponseSize, r)
+	}
+
+	return &handler{
+		next: h,
+		insts: [...]instruments{
+			schemeHTTP:  bind.Instruments(insts, semconv.URLScheme("http")),
+			schemeHTTPS: bind.Instruments(insts, semconv.URLScheme("https")),
+		},
+		byMethod: method == "",
+	}
+}
+
+// The url.scheme of a request, used as index of the instruments of a handler.
+const (
+	schemeHTTP = iota
+	schemeHTTPS
+)
+
+// scheme returns the url.scheme of the request r received by a server.
+func scheme(r *http.Request) int {
+	if r.TLS != nil {
+		return schemeHTTPS
+	}
+	return schemeHTTP
+}
+
+// parsePattern returns the method and route of a [http.ServeMux] pattern.
+func parsePattern(pattern string) (method, route string) {
+	if m, _, ok := strings.Cut(pattern, " "); ok && !strings.Contains(m, "/") {
+		method = m
+	}
+	return method, bindsemconv.HTTPRoute(pattern)
+}
+
+type handler struct {
+	next http.Handler
+	// insts are the instruments bound to each url.scheme.
+	insts [2]instruments
+
+	// byMethod is true if instruments need to be bound to the request
+	// method.
+	byMethod bool
+	// methods are the instruments bound to each request method, per
+	// url.scheme.
+	methods [2]sync.Map // map[attribute.Value]instruments
+}
+
+// instruments returns the instruments to use for r.
+func (h *handler) instruments(r *http.Request) instruments {
+	s := scheme(r)
+	if !h.byMethod {
+		return h.insts[s]
+	}
+
+	method := bindsemconv.HTTPMethod(r.Method)
+	if insts, ok := h.methods[s].Load(method.Value); ok {
+		return insts.(instruments)
+	}
+	insts, _ := h.methods[s].LoadOrStore(method.Value, bind.Instruments(h.insts[s], method))
+	return insts.(instruments)
+}
+
+// ServeHTTP records the metrics of the request r handled by the wrapped
+// handler. The metrics are recorded even if the wrapped handler panics.
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	insts := h.instruments(r)
+	ctx := r.Context()
+
+	insts.Active.Add(ctx, 1)
+	defer insts.Active.Add(ctx, -1)
+
+	body := &bodyCounter{ReadCloser: r.Body}
+	if r.Body != nil && r.Body != http.NoBody {
+		r.Body = body
+	}
+	rw := &responseWriter{ResponseWriter: w}
+
+	panicked := true
+	defer func() {
+		code := rw.statusCode()
+		if panicked && rw.status == 0 {
+			// The connection is closed without a response.
+			code = http.StatusInternalServerError
+		}
+		o := serverStatus.option(code)
+		insts.Duration.Record(ctx, time.Since(start).Seconds(), o)
+		insts.RequestSize.Record(ctx, body.n, o)
+		insts.ResponseSize.Record(ctx, rw.n, o)
+	}()
+
+	h.next.ServeHTTP(rw.wrap(), r)
+	panicked = false
+}
@@ -0,0 +1,251 @@
+package bindhttp_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/MrAlias/bind/bindhttp"
+	"github.com/MrAlias/bind/bindtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+type meterProvider struct {
+	noop.MeterProvider
+
+	meter *bindtest.Meter
+}
+
+func newMeterProvider() *meterProvider {
+	return &meterProvider{meter: bindtest.NewMeter()}
+}
+
+func (p *meterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return p.meter
+}
+
+func attrs(m []bindtest.Measurement[int64]) []attribute.Set {
+	out := make([]attribute.Set, len(m))
+	for i := range m {
+		out[i] = m[i].Attributes
+	}
+	return out
+}
+
+func TestServeMux(t *testing.T) {
+	mp := newMeterProvider()
+	mux := bindhttp.NewServeMux(
+		bindhttp.WithMeterProvider(mp),
+		bindhttp.WithAttributes(attribute.String("service", "users")),
+	)
+	mux.HandleFunc("POST /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+		_, _ = w.Write(body)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	resp, err := http.Post(srv.URL+"/users/1", "text/plain", strings.NewReader("hello"))
+	require.NoError(t, err)
+	_, _ = io.Copy(io.Discard, resp.Body)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	bound := []attribute.KeyValue{
+		attribute.String("service", "users"),
+		attribute.String("http.route", "/users/{id}"),
+		attribute.String("http.request.method", "POST"),
+		attribute.String("url.scheme", "http"),
+	}
+	want := attribute.NewSet(append(bound, attribute.Int("http.response.status_code", http.StatusCreated))...)
+
+	dur := mp.meter.Float64Measurements("http.server.request.duration")
+	require.Len(t, dur, 1)
+	assert.Equal(t, want, dur[0].Attributes)
+	assert.Positive(t, dur[0].Value)
+
+	assert.True(t, bindtest.AssertMeasurements(t, []bindtest.Measurement[int64]{
+		{Value: 5, Attributes: want},
+	}, mp.meter.Int64Measurements("http.server.request.body.size")))
+	assert.True(t, bindtest.AssertMeasurements(t, []bindtest.Measurement[int64]{
+		{Value: 10, Attributes: want},
+	}, mp.meter.Int64Measurements("http.server.response.body.size")))
+
+	active := attribute.NewSet(bound[0], bound[2], bound[3])
+	got := mp.meter.Int64Measurements("http.server.active_requests")
+	require.Len(t, got, 2)
+	assert.Equal(t, int64(1), got[0].Value)
+	assert.Equal(t, int64(-1), got[1].Value)
+	assert.Equal(t, []attribute.Set{active, active}, attrs(got))
+}
+
+func TestHandlerServerError(t *testing.T) {
+	mp := newMeterProvider()
+	h := bindhttp.Handler("/fail", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}), bindhttp.WithMeterProvider(mp))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/fail", http.NoBody))
+
+	want := attribute.NewSet(
+		attribute.String("http.route", "/fail"),
+		attribute.String("http.request.method", "GET"),
+		attribute.String("url.scheme", "http"),
+		attribute.Int("http.response.status_code", http.StatusServiceUnavailable),
+		attribute.String("error.type", "503"),
+	)
+	assert.Equal(t, []attribute.Set{want}, attrs(mp.meter.Int64Measurements("http.server.response.body.size")))
+}
+
+func TestHandlerMethodPerRequest(t *testing.T) {
+	mp := newMeterProvider()
+	h := bindhttp.Handler("/items", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, "ok")
+	}), bindhttp.WithMeterProvider(mp))
+
+	methods := []string{http.MethodGet, http.MethodPut, "PURGE"}
+	for _, m := range methods {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(m, "/items", http.NoBody))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	want := make([]attribute.Set, len(methods))
+	for i, m := range []string{"GET", "PUT", "_OTHER"} {
+		want[i] = attribute.NewSet(
+			attribute.String("http.route", "/items"),
+			attribute.String("http.request.method", m),
+			attribute.String("url.scheme", "http"),
+			attribute.Int("http.response.status_code", http.StatusOK),
+		)
+	}
+	assert.Equal(t, want, attrs(mp.meter.Int64Measurements("http.server.response.body.size")))
+}
+
+func TestHandlerScheme(t *testing.T) {
+	mp := newMeterProvider()
+	h := bindhttp.Handler("GET /", http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}), bindhttp.WithMeterProvider(mp))
+
+	srv := httptest.NewTLSServer(h)
+	defer srv.Close()
+
+	resp, err := srv.Client().Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+
+	want := make([]attribute.Set, 2)
+	for i, scheme := range []string{"https", "http"} {
+		want[i] = attribute.NewSet(
+			attribute.String("http.route", "/"),
+			attribute.String("http.request.method", "GET"),
+			attribute.String("url.scheme", scheme),
+			attribute.Int("http.response.status_code", http.StatusOK),
+		)
+	}
+	assert.Equal(t, want, attrs(mp.meter.Int64Measurements("http.server.response.body.size")))
+
+	active := attrs(mp.meter.Int64Measurements("http.server.active_requests"))
+	require.Len(t, active, 4)
+	v, _ := active[0].Value("url.scheme")
+	assert.Equal(t, "https", v.AsString(), "active requests scheme")
+}
+
+func TestHandlerConcurrent(t *testing.T) {
+	mp := newMeterProvider()
+	h := bindhttp.Handler("/", http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}), bindhttp.WithMeterProvider(mp))
+
+	const n = 10
+	var wg sync.WaitGroup
+	for range n {
+		wg.Go(func() {
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodDelete, "/", http.NoBody))
+		})
+	}
+	wg.Wait()
+
+	assert.Len(t, mp.meter.Float64Measurements("http.server.request.duration"), n)
+}
+
+func TestResponseController(t *testing.T) {
+	h := bindhttp.Handler("/", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		assert.NoError(t, http.NewResponseController(w).Flush())
+	}), bindhttp.WithMeterProvider(newMeterProvider()))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+	assert.True(t, rec.Flushed)
+}
+
+func TestHandlerInterfaces(t *testing.T) {
+	mp := newMeterProvider()
+	h := bindhttp.Handler("/", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		assert.Implements(t, (*http.Flusher)(nil), w)
+		assert.Implements(t, (*http.Hijacker)(nil), w)
+		require.Implements(t, (*io.ReaderFrom)(nil), w)
+
+		n, err := w.(io.ReaderFrom).ReadFrom(strings.NewReader("hello"))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), n)
+	}), bindhttp.WithMeterProvider(mp))
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	_, _ = io.Copy(io.Discard, resp.Body)
+	require.NoError(t, resp.Body.Close())
+
+	got := mp.meter.Int64Measurements("http.server.response.body.size")
+	require.Len(t, got, 1)
+	assert.Equal(t, int64(5), got[0].Value, "bytes written with ReadFrom")
+
+	// Interfaces not implemented by the wrapped writer are not implemented.
+	h = bindhttp.Handler("/", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		assert.Implements(t, (*http.Flusher)(nil), w)
+		_, ok := w.(http.Hijacker)
+		assert.False(t, ok, "http.Hijacker")
+		_, ok = w.(io.ReaderFrom)
+		assert.False(t, ok, "io.ReaderFrom")
+	}), bindhttp.WithMeterProvider(mp))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+}
+
+func TestHandlerPanic(t *testing.T) {
+	mp := newMeterProvider()
+	h := bindhttp.Handler("GET /panic", http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	}), bindhttp.WithMeterProvider(mp))
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/panic", http.NoBody))
+	})
+
+	want := attribute.NewSet(
+		attribute.String("http.route", "/panic"),
+		attribute.String("http.request.method", "GET"),
+		attribute.String("url.scheme", "http"),
+		attribute.Int("http.response.status_code", http.StatusInternalServerError),
+		attribute.String("error.type", "500"),
+	)
+	dur := mp.meter.Float64Measurements("http.server.request.duration")
+	require.Len(t, dur, 1, "duration should be recorded")
+	assert.Equal(t, want, dur[0].Attributes)
+	assert.Equal(t, []attribute.Set{want}, attrs(mp.meter.Int64Measurements("http.server.response.body.size")))
+
+	active := mp.meter.Int64Measurements("http.server.active_requests")
+	require.Len(t, active, 2)
+	assert.Equal(t, int64(-1), active[1].Value)
+}
@@ -0,0 +1,29 @@
+package bindhttp
+
+import "net/http"
+
+// ServeMux is a [http.ServeMux] that records HTTP server metrics for all
+// handlers registered with it (see [Handler]).
+type ServeMux struct {
+	*http.ServeMux
+
+	opts []Option
+}
+
+// NewServeMux returns a new [ServeMux] that records metrics for the handlers
+// registered with it using opts.
+func NewServeMux(opts ...Option) *ServeMux {
+	return &ServeMux{ServeMux: http.NewServeMux(), opts: opts}
+}
+
+// Handle registers the handler for pattern. The handler records HTTP server
+// metrics with instruments bound to pattern.
+func (m *ServeMux) Handle(pattern string, handler http.Handler) {
+	m.ServeMux.Handle(pattern, Handler(pattern, handler, m.opts...))
+}
+
+// HandleFunc registers the handler function for pattern. The handler records
+// HTTP server metrics with instruments bound to pattern.
+func (m *ServeMux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	m.Handle(pattern, http.HandlerFunc(handler))
+}
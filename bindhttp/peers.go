@@ -0,0 +1,63 @@
+package bindhttp
+
+import (
+	"container/list"
+	"sync"
+)
+
+// defaultMaxPeers is the default number of peers a Transport caches bound
+// instruments for.
+const defaultMaxPeers = 1024
+
+// peerEntry are the instruments bound to a peer.
+type peerEntry struct {
+	key   peerKey
+	insts clientInstruments
+}
+
+// peers is a concurrent safe LRU cache of the instruments bound to each peer.
+type peers struct {
+	size int
+
+	mu    sync.Mutex
+	ll    *list.List
+	items map[peerKey]*list.Element
+}
+
+func newPeers(size int) *peers {
+	return &peers{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[peerKey]*list.Element),
+	}
+}
+
+// get returns the instruments cached for key. If none are cached, the
+// instruments returned by newInsts are cached and returned. If the cache is
+// full, the least recently used instruments are evicted.
+func (p *peers) get(key peerKey, newInsts func() clientInstruments) clientInstruments {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if elem, ok := p.items[key]; ok {
+		p.ll.MoveToFront(elem)
+		return elem.Value.(*peerEntry).insts
+	}
+
+	if p.ll.Len() >= p.size {
+		oldest := p.ll.Back()
+		p.ll.Remove(oldest)
+		delete(p.items, oldest.Value.(*peerEntry).key)
+	}
+
+	e := &peerEntry{key: key, insts: newInsts()}
+	p.items[key] = p.ll.PushFront(e)
+	return e.insts
+}
+
+// len returns the number of cached peers.
+func (p *peers) len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.ll.Len()
+}
@@ -0,0 +1,29 @@
+package bindhttp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeersEviction(t *testing.T) {
+	p := newPeers(2)
+
+	var created []string
+	get := func(host string) {
+		p.get(peerKey{host: host}, func() clientInstruments {
+			created = append(created, host)
+			return clientInstruments{}
+		})
+	}
+
+	get("a")
+	get("b")
+	get("a") // Mark a as recently used.
+	get("c") // Evicts b.
+	assert.Equal(t, 2, p.len())
+	get("a")
+	get("b")
+
+	assert.Equal(t, []string{"a", "b", "c", "b"}, created)
+}
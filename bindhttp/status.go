@@ -0,0 +1,48 @@
+package bindhttp
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/MrAlias/bind/bindsemconv"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+)
+
+var (
+	// serverStatus are the status options of server metrics. Server errors
+	// (5xx) are errors of a server.
+	serverStatus = &statusCache{errorCode: http.StatusInternalServerError}
+	// clientStatus are the status options of client metrics. Both client
+	// (4xx) and server (5xx) errors are errors of a client.
+	clientStatus = &statusCache{errorCode: http.StatusBadRequest}
+)
+
+// statusCache caches the measurement options of response status codes.
+type statusCache struct {
+	// errorCode is the lowest status code that is an error.
+	errorCode int
+
+	opts sync.Map // map[int]metric.MeasurementOption
+}
+
+// option returns the measurement option that adds the attributes of the
+// response status code to a measurement.
+func (c *statusCache) option(code int) metric.MeasurementOption {
+	if o, ok := c.opts.Load(code); ok {
+		return o.(metric.MeasurementOption)
+	}
+
+	attrs := []attribute.KeyValue{bindsemconv.HTTPStatusCode(code)}
+	if code >= c.errorCode {
+		attrs = append(attrs, semconv.ErrorTypeKey.String(strconv.Itoa(code)))
+	}
+	o := metric.WithAttributeSet(attribute.NewSet(attrs...))
+	if code >= 100 && code < 600 {
+		// Only cache valid status codes to bound the cache size.
+		c.opts.Store(code, o)
+	}
+	return o
+}
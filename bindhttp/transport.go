@@ -0,0 +1,195 @@
+package bindhttp
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/MrAlias/bind"
+	"github.com/MrAlias/bind/bindsemconv"
+	"github.com/MrAlias/bind/internal/instconfig"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+)
+
+// clientInstruments are the instruments of a Transport.
+type clientInstruments struct {
+	Duration     metric.Float64Histogram
+	RequestSize  metric.Int64Histogram
+	ResponseSize metric.Int64Histogram
+}
+
+func newClientInstruments(m metric.Meter) clientInstruments {
+	return clientInstruments{
+		Duration: instconfig.Float64Histogram(m,
+			"http.client.request.duration",
+			metric.WithUnit("s"),
+			metric.WithDescription("Duration of HTTP client requests."),
+			metric.WithExplicitBucketBoundaries(durationBoundaries...),
+		),
+		RequestSize: instconfig.Int64Histogram(m,
+			"http.client.request.body.size",
+			metric.WithUnit("By"),
+			metric.WithDescription("Size of HTTP client request bodies."),
+		),
+		ResponseSize: instconfig.Int64Histogram(m,
+			"http.client.response.body.size",
+			metric.WithUnit("By"),
+			metric.WithDescription("Size of HTTP client response bodies."),
+		),
+	}
+}
+
+// Transport is an [http.RoundTripper] that records HTTP client metrics for
+// the requests it sends.
+//
+// The instruments are bound to the http.request.method, url.scheme,
+// server.address, and server.port of a request (see
+// [bindsemconv.HTTPClient]). They are created the first time a request with
+// these attributes is sent and reused for all following requests. Only the
+// response status code, or the type of error, is added when a measurement is
+// made. The instruments of up to 1024 peers are cached by default (see
+// [WithMaxPeers]), evicting the least recently used peer when full.
+type Transport struct {
+	base  http.RoundTripper
+	insts clientInstruments
+
+	// peers are the instruments bound to each peer.
+	peers *peers
+}
+
+var _ http.RoundTripper = (*Transport)(nil)
+
+// NewTransport returns a new [Transport] that sends requests using base. If
+// base is nil, [http.DefaultTransport] is used.
+func NewTransport(base http.RoundTripper, opts ...Option) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	c := newConfig(opts)
+	return &Transport{
+		base:  base,
+		insts: newClientInstruments(c.meter()),
+		peers: newPeers(c.maxPeers),
+	}
+}
+
+// peerKey identifies the bound instruments of a request.
+type peerKey struct {
+	method, scheme, host string
+}
+
+// instruments returns the instruments to use for r.
+func (t *Transport) instruments(r *http.Request) clientInstruments {
+	method := bindsemconv.HTTPMethod(r.Method)
+	key := peerKey{method: method.Value.AsString()}
+	if r.URL != nil {
+		key.scheme, key.host = r.URL.Scheme, r.URL.Host
+	}
+	if r.Host != "" {
+		key.host = r.Host
+	}
+
+	return t.peers.get(key, func() clientInstruments {
+		return bind.Instruments(t.insts, bindsemconv.HTTPClient(r)...)
+	})
+}
+
+// RoundTrip sends r using the underlying [http.RoundTripper] and records
+// the metrics of the request.
+//
+// The response body size is recorded when the returned response body is
+// read to completion or closed.
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	start := time.Now()
+	insts := t.instruments(r)
+	ctx := r.Context()
+
+	resp, err := t.base.RoundTrip(r)
+	elapsed := time.Since(start).Seconds()
+
+	var o metric.MeasurementOption
+	if err != nil {
+		o = metric.WithAttributes(semconv.ErrorTypeKey.String(errorType(err)))
+	} else {
+		o = clientStatus.option(resp.StatusCode)
+	}
+	insts.Duration.Record(ctx, elapsed, o)
+	if n, ok := requestSize(r); ok {
+		insts.RequestSize.Record(ctx, n, o)
+	}
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.Body != nil && resp.Body != http.NoBody {
+		if _, ok := resp.Body.(io.Writer); !ok {
+			// Bodies of protocol switching responses are also writable and
+			// are not wrapped to preserve this.
+			resp.Body = &responseBody{
+				ReadCloser: resp.Body,
+				record: func(n int64) {
+					insts.ResponseSize.Record(ctx, n, o)
+				},
+			}
+		}
+	} else {
+		insts.ResponseSize.Record(ctx, 0, o)
+	}
+	return resp, nil
+}
+
+// CloseIdleConnections closes the idle connections of the underlying
+// [http.RoundTripper] if it supports it.
+func (t *Transport) CloseIdleConnections() {
+	type closeIdler interface{ CloseIdleConnections() }
+	if c, ok := t.base.(closeIdler); ok {
+		c.CloseIdleConnections()
+	}
+}
+
+// Unwrap returns the underlying [http.RoundTripper].
+func (t *Transport) Unwrap() http.RoundTripper {
+	return t.base
+}
+
+// requestSize returns the size of the body of r, if known.
+func requestSize(r *http.Request) (int64, bool) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return 0, true
+	}
+	// A zero ContentLength with a body means the length is unknown.
+	return r.ContentLength, r.ContentLength > 0
+}
+
+// errorType returns the error.type of err, the type of the error.
+func errorType(err error) string {
+	return fmt.Sprintf("%T", err)
+}
+
+// responseBody counts the bytes read from a response body and records the
+// count once the body is read to completion or closed.
+type responseBody struct {
+	io.ReadCloser
+
+	n      int64
+	once   sync.Once
+	record func(int64)
+}
+
+func (b *responseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	if err == io.EOF {
+		b.once.Do(func() { b.record(b.n) })
+	}
+	return n, err
+}
+
+func (b *responseBody) Close() error {
+	b.once.Do(func() { b.record(b.n) })
+	return b.ReadCloser.Close()
+}
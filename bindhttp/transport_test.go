@@ -0,0 +1,176 @@
+package bindhttp_test
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/MrAlias/bind/bindhttp"
+	"github.com/MrAlias/bind/bindtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func serverAttrs(t *testing.T, srv *httptest.Server) []attribute.KeyValue {
+	t.Helper()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	host, port, err := net.SplitHostPort(u.Host)
+	require.NoError(t, err)
+	p, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return []attribute.KeyValue{
+		attribute.String("url.scheme", "http"),
+		attribute.String("server.address", host),
+		attribute.Int("server.port", p),
+	}
+}
+
+func TestTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = io.Copy(w, r.Body)
+	}))
+	defer srv.Close()
+
+	mp := newMeterProvider()
+	client := &http.Client{Transport: bindhttp.NewTransport(
+		nil,
+		bindhttp.WithMeterProvider(mp),
+		bindhttp.WithAttributes(attribute.String("peer.service", "echo")),
+	)}
+
+	resp, err := client.Post(srv.URL, "text/plain", strings.NewReader("hello"))
+	require.NoError(t, err)
+	_, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	resp, err = client.Get(srv.URL + "/missing")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	peer := append(serverAttrs(t, srv), attribute.String("peer.service", "echo"))
+	post := attribute.NewSet(slices.Concat(peer, []attribute.KeyValue{
+		attribute.String("http.request.method", "POST"),
+		attribute.Int("http.response.status_code", http.StatusOK),
+	})...)
+	get := attribute.NewSet(slices.Concat(peer, []attribute.KeyValue{
+		attribute.String("http.request.method", "GET"),
+		attribute.Int("http.response.status_code", http.StatusNotFound),
+		attribute.String("error.type", "404"),
+	})...)
+
+	dur := mp.meter.Float64Measurements("http.client.request.duration")
+	require.Len(t, dur, 2)
+	assert.Equal(t, post, dur[0].Attributes)
+	assert.Equal(t, get, dur[1].Attributes)
+
+	assert.True(t, bindtest.AssertMeasurements(t, []bindtest.Measurement[int64]{
+		{Value: 5, Attributes: post},
+		{Value: 0, Attributes: get},
+	}, mp.meter.Int64Measurements("http.client.request.body.size")))
+	assert.Equal(t, []attribute.Set{post, get}, attrs(mp.meter.Int64Measurements("http.client.response.body.size")))
+	assert.Equal(t, int64(5), mp.meter.Int64Measurements("http.client.response.body.size")[0].Value)
+}
+
+func TestTransportCachesPeers(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer srv.Close()
+
+	mp := newMeterProvider()
+	client := &http.Client{Transport: bindhttp.NewTransport(nil, bindhttp.WithMeterProvider(mp))}
+	for range 3 {
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	want := attribute.NewSet(append(serverAttrs(t, srv),
+		attribute.String("http.request.method", "GET"),
+		attribute.Int("http.response.status_code", http.StatusOK),
+	)...)
+	assert.Equal(t, []attribute.Set{want, want, want}, attrs(mp.meter.Int64Measurements("http.client.request.body.size")))
+}
+
+func TestTransportMaxPeers(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer srv.Close()
+
+	mp := newMeterProvider()
+	client := &http.Client{Transport: bindhttp.NewTransport(
+		nil,
+		bindhttp.WithMeterProvider(mp),
+		bindhttp.WithMaxPeers(1),
+	)}
+
+	hosts := []string{"a.example.com", "b.example.com", "a.example.com"}
+	for _, host := range hosts {
+		req, err := http.NewRequest(http.MethodGet, srv.URL, http.NoBody)
+		require.NoError(t, err)
+		req.Host = host
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	want := make([]attribute.Set, len(hosts))
+	for i, host := range hosts {
+		want[i] = attribute.NewSet(
+			attribute.String("http.request.method", "GET"),
+			attribute.String("url.scheme", "http"),
+			attribute.String("server.address", host),
+			attribute.Int("server.port", 80),
+			attribute.Int("http.response.status_code", http.StatusOK),
+		)
+	}
+	assert.Equal(t, want, attrs(mp.meter.Int64Measurements("http.client.request.body.size")), "evicted peers should be bound again")
+}
+
+type errTransport struct{ err error }
+
+func (t errTransport) RoundTrip(*http.Request) (*http.Response, error) { return nil, t.err }
+
+type testError struct{}
+
+func (testError) Error() string { return "test error" }
+
+func TestTransportError(t *testing.T) {
+	mp := newMeterProvider()
+	tr := bindhttp.NewTransport(errTransport{err: testError{}}, bindhttp.WithMeterProvider(mp))
+
+	r := httptest.NewRequest(http.MethodGet, "http://example.com/", http.NoBody)
+	r.RequestURI = ""
+	_, err := tr.RoundTrip(r)
+	assert.ErrorIs(t, err, testError{})
+
+	want := attribute.NewSet(
+		attribute.String("http.request.method", "GET"),
+		attribute.String("url.scheme", "http"),
+		attribute.String("server.address", "example.com"),
+		attribute.Int("server.port", 80),
+		attribute.String("error.type", "bindhttp_test.testError"),
+	)
+	dur := mp.meter.Float64Measurements("http.client.request.duration")
+	require.Len(t, dur, 1)
+	assert.Equal(t, want, dur[0].Attributes)
+	assert.Empty(t, mp.meter.Int64Measurements("http.client.response.body.size"))
+}
+
+func TestTransportUnwrap(t *testing.T) {
+	base := errTransport{}
+	tr := bindhttp.NewTransport(base)
+	assert.Equal(t, base, tr.Unwrap())
+	assert.Equal(t, http.DefaultTransport, bindhttp.NewTransport(nil).Unwrap())
+}
@@ -0,0 +1,161 @@
+package bindhttp
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+)
+
+// bodyCounter counts the bytes read from a request body.
+type bodyCounter struct {
+	io.ReadCloser
+
+	n int64
+}
+
+func (b *bodyCounter) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	return n, err
+}
+
+// responseWriter records the status code and counts the bytes written of a
+// response.
+type responseWriter struct {
+	http.ResponseWriter
+
+	status int
+	n      int64
+}
+
+// statusCode returns the status code of the response. If no status code was
+// written, http.StatusOK is returned.
+func (w *responseWriter) statusCode() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *responseWriter) WriteHeader(code int) {
+	if w.status == 0 && code >= 200 {
+		// Informational responses (1xx) may precede the final status code.
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *responseWriter) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+// Unwrap returns the wrapped [http.ResponseWriter]. It is used by
+// [http.ResponseController] to access optional interfaces, like
+// [http.Flusher].
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *responseWriter) flush() {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.ResponseWriter.(http.Flusher).Flush()
+}
+
+func (w *responseWriter) hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return w.ResponseWriter.(http.Hijacker).Hijack()
+}
+
+func (w *responseWriter) readFrom(src io.Reader) (int64, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.(io.ReaderFrom).ReadFrom(src)
+	w.n += n
+	return n, err
+}
+
+// flusher implements [http.Flusher] for a responseWriter wrapping an
+// [http.Flusher].
+type flusher struct{ w *responseWriter }
+
+func (f flusher) Flush() {
+	f.w.flush()
+}
+
+// hijacker implements [http.Hijacker] for a responseWriter wrapping an
+// [http.Hijacker].
+type hijacker struct{ w *responseWriter }
+
+func (h hijacker) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return h.w.hijack()
+}
+
+// readerFrom implements [io.ReaderFrom] for a responseWriter wrapping an
+// [io.ReaderFrom].
+type readerFrom struct{ w *responseWriter }
+
+func (r readerFrom) ReadFrom(src io.Reader) (int64, error) {
+	return r.w.readFrom(src)
+}
+
+// wrap returns w as an [http.ResponseWriter] that implements the same of the
+// [http.Flusher], [http.Hijacker], and [io.ReaderFrom] interfaces as the
+// wrapped writer, so type assertions of handlers keep working.
+func (w *responseWriter) wrap() http.ResponseWriter {
+	_, f := w.ResponseWriter.(http.Flusher)
+	_, h := w.ResponseWriter.(http.Hijacker)
+	_, r := w.ResponseWriter.(io.ReaderFrom)
+
+	switch {
+	case f && h && r:
+		return struct {
+			*responseWriter
+			flusher
+			hijacker
+			readerFrom
+		}{w, flusher{w}, hijacker{w}, readerFrom{w}}
+	case f && h:
+		return struct {
+			*responseWriter
+			flusher
+			hijacker
+		}{w, flusher{w}, hijacker{w}}
+	case f && r:
+		return struct {
+			*responseWriter
+			flusher
+			readerFrom
+		}{w, flusher{w}, readerFrom{w}}
+	case h && r:
+		return struct {
+			*responseWriter
+			hijacker
+			readerFrom
+		}{w, hijacker{w}, readerFrom{w}}
+	case f:
+		return struct {
+			*responseWriter
+			flusher
+		}{w, flusher{w}}
+	case h:
+		return struct {
+			*responseWriter
+			hijacker
+		}{w, hijacker{w}}
+	case r:
+		return struct {
+			*responseWriter
+			readerFrom
+		}{w, readerFrom{w}}
+	default:
+		return w
+	}
+}
@@ -0,0 +1,147 @@
+package bindlog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/MrAlias/bind/bindlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+var (
+	tenant    = attribute.String("tenant", "acme")
+	component = attribute.String("component", "billing")
+)
+
+type mockLogger struct {
+	noop.Logger
+
+	name    string
+	records []log.Record
+	enabled bool
+	param   log.EnabledParameters
+}
+
+func (l *mockLogger) Emit(_ context.Context, r log.Record) {
+	l.records = append(l.records, r.Clone())
+}
+
+func (l *mockLogger) Enabled(_ context.Context, param log.EnabledParameters) bool {
+	l.param = param
+	return l.enabled
+}
+
+type mockLoggerProvider struct {
+	noop.LoggerProvider
+
+	loggers []*mockLogger
+}
+
+func (p *mockLoggerProvider) Logger(name string, _ ...log.LoggerOption) log.Logger {
+	l := &mockLogger{name: name}
+	p.loggers = append(p.loggers, l)
+	return l
+}
+
+func attrs(r log.Record) map[string]string {
+	m := make(map[string]string)
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		m[kv.Key] = kv.Value.String()
+		return true
+	})
+	return m
+}
+
+func TestLogger(t *testing.T) {
+	mock := new(mockLogger)
+	l := bindlog.Logger(mock, tenant, component)
+
+	var r log.Record
+	r.SetBody(log.StringValue("hello"))
+	l.Emit(t.Context(), r)
+
+	// Attributes of the record take precedence.
+	r.AddAttributes(log.String("tenant", "other"), log.Int("id", 1))
+	l.Emit(t.Context(), r)
+
+	require.Len(t, mock.records, 2)
+	assert.Equal(t, map[string]string{"tenant": "acme", "component": "billing"}, attrs(mock.records[0]))
+	assert.Equal(t, map[string]string{"tenant": "other", "component": "billing", "id": "1"}, attrs(mock.records[1]))
+	assert.Equal(t, 2, r.AttributesLen(), "caller record modified")
+}
+
+func TestLoggerDoesNotModifyRecord(t *testing.T) {
+	mock := new(mockLogger)
+	l := bindlog.Logger(mock, tenant)
+
+	var r log.Record
+	for i := range 6 {
+		r.AddAttributes(log.Int("attr", i))
+	}
+	// Emit a copy with spare capacity shared with r.
+	shared := r
+	l.Emit(t.Context(), shared)
+	r.AddAttributes(log.String("last", "value"))
+
+	require.Len(t, mock.records, 1)
+	assert.Equal(t, "acme", attrs(mock.records[0])["tenant"])
+	assert.Equal(t, "value", attrs(r)["last"])
+	assert.NotContains(t, attrs(r), "tenant")
+}
+
+func TestLoggerEnabled(t *testing.T) {
+	mock := &mockLogger{enabled: true}
+	l := bindlog.Logger(mock, tenant)
+
+	param := log.EnabledParameters{Severity: log.SeverityWarn, EventName: "event"}
+	assert.True(t, l.Enabled(t.Context(), param))
+	assert.Equal(t, param, mock.param)
+
+	mock.enabled = false
+	assert.False(t, l.Enabled(t.Context(), param))
+}
+
+func TestLoggerFlatten(t *testing.T) {
+	mock := new(mockLogger)
+	acme := attribute.String("tenant", "globex")
+	l := bindlog.Logger(bindlog.Logger(mock, tenant, component), acme)
+
+	inner, set := bind.Unwrap(l)
+	assert.Same(t, mock, inner, "flattened")
+	assert.Equal(t, attribute.NewSet(acme, component), set)
+
+	assert.Same(t, mock, bindlog.Logger(mock), "no attributes")
+}
+
+func TestLoggerProvider(t *testing.T) {
+	mock := new(mockLoggerProvider)
+	lp := bindlog.LoggerProvider(bindlog.LoggerProvider(mock, tenant), component)
+
+	inner, set := bind.Unwrap(lp)
+	assert.Same(t, mock, inner, "flattened")
+	assert.Equal(t, attribute.NewSet(tenant, component), set)
+
+	lp.Logger("scope").Emit(t.Context(), log.Record{})
+	require.Len(t, mock.loggers, 1)
+	assert.Equal(t, "scope", mock.loggers[0].name)
+	require.Len(t, mock.loggers[0].records, 1)
+	assert.Equal(t, map[string]string{"tenant": "acme", "component": "billing"}, attrs(mock.loggers[0].records[0]))
+
+	var p log.LoggerProvider = mock
+	assert.Same(t, p, bindlog.LoggerProvider(p), "no attributes")
+}
+
+func TestLoggerEmitAllocs(t *testing.T) {
+	l := bindlog.Logger(noop.Logger{}, tenant, component)
+
+	var r log.Record
+	r.AddAttributes(log.Int("id", 1))
+	ctx := t.Context()
+	allocs := testing.AllocsPerRun(100, func() { l.Emit(ctx, r) })
+	assert.Zero(t, allocs)
+}
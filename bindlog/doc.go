@@ -0,0 +1,21 @@
+/*
+Package bindlog binds attributes to OpenTelemetry Logs API loggers.
+
+All records emitted by a bound [go.opentelemetry.io/otel/log.Logger] include
+the bound attributes. Attributes are bound using the same
+[go.opentelemetry.io/otel/attribute.KeyValue] type used to bind metric
+instruments and tracers, so one attribute list can be bound across signals:
+
+	attrs := []attribute.KeyValue{
+		attribute.String("tenant", "acme"),
+		attribute.String("component", "billing"),
+	}
+	mp := bind.MeterProvider(otel.GetMeterProvider(), attrs...)
+	tp := bind.TracerProvider(otel.GetTracerProvider(), attrs...)
+	lp := bindlog.LoggerProvider(global.GetLoggerProvider(), attrs...)
+
+The bound attributes are converted to log attributes once, when binding.
+
+This is a separate module from bind because the Logs API is not yet stable.
+*/
+package bindlog
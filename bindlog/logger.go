@@ -0,0 +1,143 @@
+package bindlog
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/embedded"
+)
+
+// Logger binds attrs to l. All records emitted by the returned [log.Logger]
+// include attrs. Attributes of an emitted record take precedence over attrs
+// with the same key.
+//
+// If l is already bound to attributes, attrs will be merged into those
+// attributes for the returned logger.
+func Logger(l log.Logger, attrs ...attribute.KeyValue) log.Logger {
+	if len(attrs) == 0 {
+		return l
+	}
+
+	var all []attribute.KeyValue
+	if b, ok := l.(*logger); ok {
+		// Flatten the logger if already bound.
+		l = b.logger
+		all = make([]attribute.KeyValue, 0, b.set.Len()+len(attrs))
+		all = append(all, b.set.ToSlice()...)
+	} else {
+		// NewSet sorts passed attributes. Copy to avoid side effect.
+		all = make([]attribute.KeyValue, 0, len(attrs))
+	}
+	set := attribute.NewSet(append(all, attrs...)...)
+
+	kvs := make([]log.KeyValue, 0, set.Len())
+	for iter := set.Iter(); iter.Next(); {
+		kvs = append(kvs, log.KeyValueFromAttribute(iter.Attribute()))
+	}
+	return &logger{logger: l, set: set, kvs: kvs}
+}
+
+type logger struct {
+	embedded.Logger
+
+	logger log.Logger
+	set    attribute.Set
+	// kvs are the bound attributes converted to log attributes.
+	kvs []log.KeyValue
+}
+
+var _ log.Logger = (*logger)(nil)
+
+// Unwrap returns the underlying [log.Logger] and bound attributes.
+func (l *logger) Unwrap() (log.Logger, attribute.Set) {
+	return l.logger, l.set
+}
+
+// Emit emits r using the underlying [log.Logger] with the bound attributes
+// added.
+func (l *logger) Emit(ctx context.Context, r log.Record) {
+	n := r.AttributesLen()
+	if n == 0 {
+		r.AddAttributes(l.kvs...)
+		l.logger.Emit(ctx, r)
+		return
+	}
+
+	if n > inlineAttributes {
+		// The attributes of r that are not stored inline can be shared with
+		// the caller's record. Clone so adding attributes cannot modify it.
+		r = r.Clone()
+	}
+	for i := range l.kvs {
+		if !hasKey(&r, l.kvs[i].Key) {
+			r.AddAttributes(l.kvs[i])
+		}
+	}
+	l.logger.Emit(ctx, r)
+}
+
+// Enabled returns whether the underlying [log.Logger] is enabled for param.
+func (l *logger) Enabled(ctx context.Context, param log.EnabledParameters) bool {
+	return l.logger.Enabled(ctx, param)
+}
+
+// inlineAttributes is the number of attributes a log.Record stores without
+// allocating.
+const inlineAttributes = 5
+
+// hasKey returns whether r has an attribute with key.
+func hasKey(r *log.Record, key string) bool {
+	var found bool
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		found = kv.Key == key
+		return !found
+	})
+	return found
+}
+
+// LoggerProvider binds attrs to lp. All [log.Logger] returned by the returned
+// [log.LoggerProvider] will be bound to attrs (see [Logger]).
+//
+// If lp is already bound to attributes, attrs will be merged into those
+// attributes for the returned provider.
+func LoggerProvider(lp log.LoggerProvider, attrs ...attribute.KeyValue) log.LoggerProvider {
+	if len(attrs) == 0 {
+		return lp
+	}
+
+	var all []attribute.KeyValue
+	if p, ok := lp.(*loggerProvider); ok {
+		// Flatten the provider if already bound.
+		lp = p.lp
+		all = make([]attribute.KeyValue, 0, p.set.Len()+len(attrs))
+		all = append(all, p.set.ToSlice()...)
+	} else {
+		// NewSet sorts passed attributes. Copy to avoid side effect.
+		all = make([]attribute.KeyValue, 0, len(attrs))
+	}
+	set := attribute.NewSet(append(all, attrs...)...)
+
+	return &loggerProvider{lp: lp, set: set, attrs: set.ToSlice()}
+}
+
+type loggerProvider struct {
+	embedded.LoggerProvider
+
+	lp    log.LoggerProvider
+	set   attribute.Set
+	attrs []attribute.KeyValue
+}
+
+var _ log.LoggerProvider = (*loggerProvider)(nil)
+
+// Unwrap returns the underlying [log.LoggerProvider] and bound attributes.
+func (p *loggerProvider) Unwrap() (log.LoggerProvider, attribute.Set) {
+	return p.lp, p.set
+}
+
+// Logger returns a [log.Logger] from the underlying provider bound to the
+// provider's attributes.
+func (p *loggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logger {
+	return Logger(p.lp.Logger(name, opts...), p.attrs...)
+}
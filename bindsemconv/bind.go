@@ -0,0 +1,56 @@
+package bindsemconv
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/MrAlias/bind"
+)
+
+// BindHTTPServer binds the attributes of HTTP server metrics for r (see
+// [HTTPServer]) to inst using [bind.Bind].
+//
+// T needs to be one of the types supported by [bind.Bind].
+func BindHTTPServer[T any](inst T, r *http.Request) T {
+	return bind.Bind(inst, HTTPServer(r)...)
+}
+
+// BindHTTPClient binds the attributes of HTTP client metrics for r (see
+// [HTTPClient]) to inst using [bind.Bind].
+//
+// T needs to be one of the types supported by [bind.Bind].
+func BindHTTPClient[T any](inst T, r *http.Request) T {
+	return bind.Bind(inst, HTTPClient(r)...)
+}
+
+// BindDB binds the attributes of database client metrics for driverName and
+// namespace (see [DB]) to inst using [bind.Bind].
+//
+// T needs to be one of the types supported by [bind.Bind].
+func BindDB[T any](inst T, driverName, namespace string) T {
+	return bind.Bind(inst, DB(driverName, namespace)...)
+}
+
+// BindMessaging binds the attributes of messaging metrics for system and
+// destination (see [Messaging]) to inst using [bind.Bind].
+//
+// T needs to be one of the types supported by [bind.Bind].
+func BindMessaging[T any](inst T, system, destination string) T {
+	return bind.Bind(inst, Messaging(system, destination)...)
+}
+
+// BindPeer binds the attributes of the remote peer address addr (see [Peer])
+// to inst using [bind.Bind].
+//
+// T needs to be one of the types supported by [bind.Bind].
+func BindPeer[T any](inst T, addr net.Addr) T {
+	return bind.Bind(inst, Peer(addr)...)
+}
+
+// BindServer binds the attributes of the server address addr (see [Server])
+// to inst using [bind.Bind].
+//
+// T needs to be one of the types supported by [bind.Bind].
+func BindServer[T any](inst T, addr net.Addr) T {
+	return bind.Bind(inst, Server(addr)...)
+}
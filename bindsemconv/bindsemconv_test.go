@@ -0,0 +1,157 @@
+package bindsemconv_test
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/MrAlias/bind/bindsemconv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+func TestHTTPMethod(t *testing.T) {
+	assert.Equal(t, attribute.String("http.request.method", "GET"), bindsemconv.HTTPMethod(""))
+	assert.Equal(t, attribute.String("http.request.method", "POST"), bindsemconv.HTTPMethod(http.MethodPost))
+	assert.Equal(t, attribute.String("http.request.method", "_OTHER"), bindsemconv.HTTPMethod("PURGE"))
+}
+
+func TestHTTPServer(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/users/1", http.NoBody)
+	r.Pattern = "GET /users/{id}"
+
+	want := []attribute.KeyValue{
+		attribute.String("http.request.method", "GET"),
+		attribute.String("http.route", "/users/{id}"),
+		attribute.String("url.scheme", "http"),
+		attribute.String("network.protocol.version", "1.1"),
+	}
+	assert.Equal(t, want, bindsemconv.HTTPServer(r))
+}
+
+func TestHTTPServerTLS(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPut, "https://[::1]:8443/", http.NoBody)
+	r.TLS = &tls.ConnectionState{}
+	r.ProtoMajor, r.ProtoMinor = 2, 0
+
+	want := []attribute.KeyValue{
+		attribute.String("http.request.method", "PUT"),
+		attribute.String("url.scheme", "https"),
+		attribute.String("network.protocol.version", "2"),
+	}
+	assert.Equal(t, want, bindsemconv.HTTPServer(r))
+}
+
+func TestHTTPClient(t *testing.T) {
+	r, err := http.NewRequest(http.MethodPost, "https://api.example.com/v1/users", http.NoBody)
+	require.NoError(t, err)
+
+	want := []attribute.KeyValue{
+		attribute.String("http.request.method", "POST"),
+		attribute.String("url.scheme", "https"),
+		attribute.String("server.address", "api.example.com"),
+		attribute.Int("server.port", 443),
+	}
+	assert.Equal(t, want, bindsemconv.HTTPClient(r))
+
+	r.Host = "override.example.com:8080"
+	want[2] = attribute.String("server.address", "override.example.com")
+	want[3] = attribute.Int("server.port", 8080)
+	assert.Equal(t, want, bindsemconv.HTTPClient(r), "Host override")
+}
+
+func TestHTTPRoute(t *testing.T) {
+	assert.Equal(t, "/users/{id}", bindsemconv.HTTPRoute("GET example.com/users/{id}"))
+	assert.Equal(t, "/", bindsemconv.HTTPRoute("/"))
+	assert.Empty(t, bindsemconv.HTTPRoute(""))
+}
+
+func TestHTTPStatusCode(t *testing.T) {
+	assert.Equal(t, attribute.Int("http.response.status_code", 404), bindsemconv.HTTPStatusCode(http.StatusNotFound))
+}
+
+func TestPeer(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 5432}
+	want := []attribute.KeyValue{
+		attribute.String("network.peer.address", "192.0.2.1"),
+		attribute.Int("network.peer.port", 5432),
+		attribute.String("network.type", "ipv4"),
+		attribute.String("network.transport", "tcp"),
+	}
+	assert.Equal(t, want, bindsemconv.Peer(addr))
+
+	assert.Nil(t, bindsemconv.Peer(nil))
+}
+
+func TestServer(t *testing.T) {
+	addr := &net.UDPAddr{IP: net.ParseIP("2001:db8::1"), Port: 53}
+	want := []attribute.KeyValue{
+		attribute.String("server.address", "2001:db8::1"),
+		attribute.Int("server.port", 53),
+		attribute.String("network.type", "ipv6"),
+		attribute.String("network.transport", "udp"),
+	}
+	assert.Equal(t, want, bindsemconv.Server(addr))
+
+	unix := &net.UnixAddr{Name: "/tmp/app.sock", Net: "unix"}
+	want = []attribute.KeyValue{
+		attribute.String("server.address", "/tmp/app.sock"),
+		attribute.String("network.transport", "unix"),
+	}
+	assert.Equal(t, want, bindsemconv.Server(unix))
+}
+
+func TestDB(t *testing.T) {
+	assert.Equal(t, attribute.String("db.system.name", "postgresql"), bindsemconv.DBSystem("pgx"))
+	assert.Equal(t, attribute.String("db.system.name", "other_sql"), bindsemconv.DBSystem("unknown"))
+
+	want := []attribute.KeyValue{
+		attribute.String("db.system.name", "mysql"),
+		attribute.String("db.namespace", "shop"),
+	}
+	assert.Equal(t, want, bindsemconv.DB("mysql", "shop"))
+	assert.Equal(t, want[:1], bindsemconv.DB("mysql", ""))
+}
+
+func TestMessaging(t *testing.T) {
+	want := []attribute.KeyValue{
+		attribute.String("messaging.system", "kafka"),
+		attribute.String("messaging.destination.name", "orders"),
+	}
+	assert.Equal(t, want, bindsemconv.Messaging("kafka", "orders"))
+}
+
+func TestBind(t *testing.T) {
+	var inst metric.Int64Counter = noop.Int64Counter{}
+	r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	addr := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 5432}
+
+	tests := []struct {
+		name string
+		got  metric.Int64Counter
+		want []attribute.KeyValue
+	}{
+		{"HTTPServer", bindsemconv.BindHTTPServer(inst, r), bindsemconv.HTTPServer(r)},
+		{"HTTPClient", bindsemconv.BindHTTPClient(inst, r), bindsemconv.HTTPClient(r)},
+		{"DB", bindsemconv.BindDB(inst, "mysql", "shop"), bindsemconv.DB("mysql", "shop")},
+		{"Messaging", bindsemconv.BindMessaging(inst, "kafka", "orders"), bindsemconv.Messaging("kafka", "orders")},
+		{"Peer", bindsemconv.BindPeer(inst, addr), bindsemconv.Peer(addr)},
+		{"Server", bindsemconv.BindServer(inst, addr), bindsemconv.Server(addr)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, set := bind.Unwrap(tt.got)
+			assert.Equal(t, attribute.NewSet(tt.want...), set)
+		})
+	}
+}
+
+func TestSchemaURL(t *testing.T) {
+	assert.Equal(t, "https://opentelemetry.io/schemas/"+bindsemconv.Version, bindsemconv.SchemaURL)
+}
@@ -0,0 +1,49 @@
+package bindsemconv
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+)
+
+// dbSystems are the db.system.name attributes of common database/sql driver
+// names.
+var dbSystems = map[string]attribute.KeyValue{
+	"postgres":   semconv.DBSystemNamePostgreSQL,
+	"pgx":        semconv.DBSystemNamePostgreSQL,
+	"cockroach":  semconv.DBSystemNameCockroachDB,
+	"mysql":      semconv.DBSystemNameMySQL,
+	"sqlite":     semconv.DBSystemNameSQLite,
+	"sqlite3":    semconv.DBSystemNameSQLite,
+	"sqlserver":  semconv.DBSystemNameMicrosoftSQLServer,
+	"mssql":      semconv.DBSystemNameMicrosoftSQLServer,
+	"oracle":     semconv.DBSystemNameOracleDB,
+	"godror":     semconv.DBSystemNameOracleDB,
+	"clickhouse": semconv.DBSystemNameClickHouse,
+	"spanner":    semconv.DBSystemNameGCPSpanner,
+	"trino":      semconv.DBSystemNameTrino,
+	"hdb":        semconv.DBSystemNameSAPHANA,
+}
+
+// DBSystem returns the db.system.name attribute for the database/sql driver
+// name passed to [database/sql.Open] or [database/sql.Register]. Unknown
+// drivers are reported as "other_sql".
+func DBSystem(driverName string) attribute.KeyValue {
+	if kv, ok := dbSystems[driverName]; ok {
+		return kv
+	}
+	return semconv.DBSystemNameOtherSQL
+}
+
+// DB returns the attributes of database client metrics for a database/sql
+// driver name and the database namespace, e.g. the database name:
+//
+//   - db.system.name
+//   - db.namespace, if namespace is not empty
+func DB(driverName, namespace string) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 1, 2)
+	attrs[0] = DBSystem(driverName)
+	if namespace != "" {
+		attrs = append(attrs, semconv.DBNamespace(namespace))
+	}
+	return attrs
+}
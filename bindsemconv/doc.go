@@ -0,0 +1,47 @@
+/*
+Package bindsemconv provides builders of attribute sets that comply with the
+OpenTelemetry semantic conventions for common bound instruments. The
+attributes are computed from standard library types and can be bound to
+instruments using the Bind functions of this package (e.g. [BindHTTPServer])
+or the functions of the bind package.
+
+Example usage:
+
+	func handle(w http.ResponseWriter, r *http.Request) {
+		counter := bindsemconv.BindHTTPServer(requests, r)
+		counter.Add(r.Context(), 1)
+	}
+
+Values a remote peer controls, e.g. the Host header of a request received by
+an HTTP server, are not included so the number of attribute sets stays
+bounded when binding per request.
+
+# Semantic conventions version
+
+The attributes comply with version [Version] of the semantic conventions. Use
+[SchemaURL] as the schema URL of instrumentation scopes that use these
+attributes:
+
+	meter := provider.Meter("example", metric.WithSchemaURL(bindsemconv.SchemaURL))
+
+The version is pinned so the attributes of existing instruments do not change
+unexpectedly. Upgrading to a newer version of the semantic conventions is
+done in a minor release of this module and is listed in its changelog
+together with any attributes that changed. To upgrade, the semconv package
+imported by this package is replaced with the newer version and the
+attributes are updated according to the migration guide of the semantic
+conventions. Code that depends on specific attribute keys or values should
+use the constants of the matching semconv package and compare [SchemaURL]
+after upgrading.
+*/
+package bindsemconv
+
+import semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+
+// Version is the version of the semantic conventions the attributes comply
+// with.
+const Version = "1.41.0"
+
+// SchemaURL is the schema URL of the semantic conventions the attributes
+// comply with.
+const SchemaURL = semconv.SchemaURL
@@ -0,0 +1,22 @@
+package bindsemconv_test
+
+import (
+	"net/http"
+
+	"github.com/MrAlias/bind/bindsemconv"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func Example() {
+	meter := otel.Meter("example", metric.WithSchemaURL(bindsemconv.SchemaURL))
+	requests, _ := meter.Int64Counter("http.server.requests")
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		counter := bindsemconv.BindHTTPServer(requests, r)
+		counter.Add(r.Context(), 1)
+
+		w.WriteHeader(http.StatusOK)
+	}
+	http.HandleFunc("GET /users/{id}", handler)
+}
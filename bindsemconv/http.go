@@ -0,0 +1,152 @@
+package bindsemconv
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+)
+
+// methods are the known HTTP request methods.
+var methods = map[string]attribute.KeyValue{
+	http.MethodConnect: semconv.HTTPRequestMethodConnect,
+	http.MethodDelete:  semconv.HTTPRequestMethodDelete,
+	http.MethodGet:     semconv.HTTPRequestMethodGet,
+	http.MethodHead:    semconv.HTTPRequestMethodHead,
+	http.MethodOptions: semconv.HTTPRequestMethodOptions,
+	http.MethodPatch:   semconv.HTTPRequestMethodPatch,
+	http.MethodPost:    semconv.HTTPRequestMethodPost,
+	http.MethodPut:     semconv.HTTPRequestMethodPut,
+	http.MethodTrace:   semconv.HTTPRequestMethodTrace,
+}
+
+// HTTPMethod returns the http.request.method attribute for method. Unknown
+// methods are reported as "_OTHER" to limit the attribute's cardinality. An
+// empty method is the GET method.
+func HTTPMethod(method string) attribute.KeyValue {
+	if method == "" {
+		return semconv.HTTPRequestMethodGet
+	}
+	if kv, ok := methods[method]; ok {
+		return kv
+	}
+	return semconv.HTTPRequestMethodOther
+}
+
+// HTTPServer returns the attributes of HTTP server metrics for the request r
+// received by a server:
+//
+//   - http.request.method
+//   - http.route, if r was matched by a [http.ServeMux] pattern
+//   - url.scheme
+//   - network.protocol.version
+//
+// The server.address and server.port attributes are not included. They would
+// be computed from the Host of r, which is set by the client and would let
+// clients create an unbounded number of attribute sets. Use [Server] with the
+// local address of the server to include them.
+//
+// The status code of the response is not known before the request is handled
+// and needs to be added when making a measurement (see [HTTPStatusCode]).
+func HTTPServer(r *http.Request) []attribute.KeyValue {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	attrs := make([]attribute.KeyValue, 0, 4)
+	attrs = append(attrs, HTTPMethod(r.Method))
+	if route := HTTPRoute(r.Pattern); route != "" {
+		attrs = append(attrs, semconv.HTTPRoute(route))
+	}
+	attrs = append(attrs, semconv.URLScheme(scheme))
+	if v := protocolVersion(r.ProtoMajor, r.ProtoMinor); v != "" {
+		attrs = append(attrs, semconv.NetworkProtocolVersion(v))
+	}
+	return attrs
+}
+
+// HTTPClient returns the attributes of HTTP client metrics for the request r
+// sent by a client:
+//
+//   - http.request.method
+//   - url.scheme
+//   - server.address and server.port
+//
+// The status code of the response is not known before the request is sent
+// and needs to be added when making a measurement (see [HTTPStatusCode]).
+func HTTPClient(r *http.Request) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, 4)
+	attrs = append(attrs, HTTPMethod(r.Method))
+
+	if r.URL == nil {
+		return attrs
+	}
+	scheme := r.URL.Scheme
+	if scheme != "" {
+		attrs = append(attrs, semconv.URLScheme(scheme))
+	}
+	host := r.URL.Host
+	if r.Host != "" {
+		host = r.Host
+	}
+	return appendServer(attrs, host, scheme)
+}
+
+// HTTPRoute returns the http.route of a [http.ServeMux] pattern, e.g.
+// "/users/{id}" for the pattern "GET example.com/users/{id}". An empty
+// string is returned if pattern has no path.
+func HTTPRoute(pattern string) string {
+	if i := strings.IndexByte(pattern, '/'); i >= 0 {
+		return pattern[i:]
+	}
+	return ""
+}
+
+// HTTPStatusCode returns the http.response.status_code attribute for code.
+func HTTPStatusCode(code int) attribute.KeyValue {
+	return semconv.HTTPResponseStatusCode(code)
+}
+
+// appendServer appends the server.address and server.port attributes of
+// host to attrs. If host has no port, the default port of scheme is used.
+func appendServer(attrs []attribute.KeyValue, host, scheme string) []attribute.KeyValue {
+	if host == "" {
+		return attrs
+	}
+
+	addr, p, err := net.SplitHostPort(host)
+	if err != nil {
+		// No port.
+		addr = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	}
+	attrs = append(attrs, semconv.ServerAddress(addr))
+
+	port, err := strconv.Atoi(p)
+	if err != nil {
+		switch scheme {
+		case "http":
+			port = 80
+		case "https":
+			port = 443
+		default:
+			return attrs
+		}
+	}
+	return append(attrs, semconv.ServerPort(port))
+}
+
+// protocolVersion returns the network.protocol.version of an HTTP request.
+func protocolVersion(major, minor int) string {
+	switch {
+	case major == 0:
+		return ""
+	case major >= 2:
+		return strconv.Itoa(major)
+	default:
+		return strconv.Itoa(major) + "." + strconv.Itoa(minor)
+	}
+}
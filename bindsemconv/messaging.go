@@ -0,0 +1,20 @@
+package bindsemconv
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+)
+
+// Messaging returns the attributes of messaging metrics for the messaging
+// system (e.g. "kafka") and destination, e.g. a topic or queue name:
+//
+//   - messaging.system
+//   - messaging.destination.name, if destination is not empty
+func Messaging(system, destination string) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 1, 2)
+	attrs[0] = semconv.MessagingSystemKey.String(system)
+	if destination != "" {
+		attrs = append(attrs, semconv.MessagingDestinationName(destination))
+	}
+	return attrs
+}
@@ -0,0 +1,80 @@
+package bindsemconv
+
+import (
+	"net"
+	"net/netip"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+)
+
+// Peer returns the attributes of the remote peer address addr of a network
+// connection:
+//
+//   - network.peer.address
+//   - network.peer.port, if addr has a port
+//   - network.transport, if known for the network of addr
+//   - network.type, if addr is an IP address
+//
+// No attributes are returned if addr is nil.
+func Peer(addr net.Addr) []attribute.KeyValue {
+	return netAttrs(addr, semconv.NetworkPeerAddress, semconv.NetworkPeerPort)
+}
+
+// Server returns the attributes of the server address addr:
+//
+//   - server.address
+//   - server.port, if addr has a port
+//   - network.transport, if known for the network of addr
+//   - network.type, if addr is an IP address
+//
+// No attributes are returned if addr is nil.
+func Server(addr net.Addr) []attribute.KeyValue {
+	return netAttrs(addr, semconv.ServerAddress, semconv.ServerPort)
+}
+
+func netAttrs(addr net.Addr, address func(string) attribute.KeyValue, port func(int) attribute.KeyValue) []attribute.KeyValue {
+	if addr == nil {
+		return nil
+	}
+
+	attrs := make([]attribute.KeyValue, 0, 4)
+	var ap netip.AddrPort
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		ap = a.AddrPort()
+	case *net.UDPAddr:
+		ap = a.AddrPort()
+	default:
+		attrs = append(attrs, address(addr.String()))
+	}
+	if ap.IsValid() {
+		ip := ap.Addr().Unmap()
+		attrs = append(attrs, address(ip.String()), port(int(ap.Port())))
+		if ip.Is4() {
+			attrs = append(attrs, semconv.NetworkTypeIPv4)
+		} else {
+			attrs = append(attrs, semconv.NetworkTypeIPv6)
+		}
+	}
+
+	if t, ok := transport(addr.Network()); ok {
+		attrs = append(attrs, t)
+	}
+	return attrs
+}
+
+// transport returns the network.transport attribute of a Go network name.
+func transport(network string) (attribute.KeyValue, bool) {
+	switch {
+	case strings.HasPrefix(network, "tcp"):
+		return semconv.NetworkTransportTCP, true
+	case strings.HasPrefix(network, "udp"):
+		return semconv.NetworkTransportUDP, true
+	case strings.HasPrefix(network, "unix"):
+		return semconv.NetworkTransportUnix, true
+	default:
+		return attribute.KeyValue{}, false
+	}
+}
@@ -0,0 +1,358 @@
+package bindsql_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"github.com/MrAlias/bind/bindsql"
+	"github.com/MrAlias/bind/bindtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+type queryError struct{}
+
+func (queryError) Error() string { return "query failed" }
+
+// fakeDriver is a driver of connections that fail queries of "fail" and
+// return no rows for all other queries.
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConnector struct{}
+
+func (fakeConnector) Connect(context.Context) (driver.Conn, error) { return fakeConn{}, nil }
+func (fakeConnector) Driver() driver.Driver                        { return fakeDriver{} }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (fakeConn) Close() error                        { return nil }
+func (fakeConn) Begin() (driver.Tx, error)           { return fakeTx{}, nil }
+
+func (fakeConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	return fakeTx{}, nil
+}
+
+func (fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if query == "fail" {
+		return nil, queryError{}
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if query == "fail" {
+		return nil, queryError{}
+	}
+	return fakeRows{}, nil
+}
+
+func (fakeConn) Ping(context.Context) error { return nil }
+
+// legacyConn is a connection that only implements driver.Conn.
+type legacyConn struct{}
+
+func (legacyConn) Prepare(string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (legacyConn) Close() error                        { return nil }
+func (legacyConn) Begin() (driver.Tx, error)           { return fakeTx{}, nil }
+
+type legacyDriver struct{}
+
+func (legacyDriver) Open(string) (driver.Conn, error) { return legacyConn{}, nil }
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error                               { return nil }
+func (fakeStmt) NumInput() int                              { return -1 }
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(1), nil }
+func (fakeStmt) Query([]driver.Value) (driver.Rows, error)  { return fakeRows{}, nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+type fakeRows struct{}
+
+func (fakeRows) Columns() []string         { return nil }
+func (fakeRows) Close() error              { return nil }
+func (fakeRows) Next([]driver.Value) error { return io.EOF }
+
+func init() {
+	sql.Register("bindsql-fake", fakeDriver{})
+}
+
+type mockInt64ObservableCounter struct {
+	noop.Int64ObservableCounter
+
+	name string
+}
+
+type mockInt64ObservableUpDownCounter struct {
+	noop.Int64ObservableUpDownCounter
+
+	name string
+}
+
+type mockFloat64ObservableCounter struct {
+	noop.Float64ObservableCounter
+
+	name string
+}
+
+type mockRegistration struct{ embedded.Registration }
+
+func (mockRegistration) Unregister() error { return nil }
+
+// meter is a bindtest.Meter that also creates observable instruments and
+// keeps registered callbacks.
+type meter struct {
+	*bindtest.Meter
+
+	callbacks []metric.Callback
+}
+
+func (*meter) Int64ObservableCounter(name string, _ ...metric.Int64ObservableCounterOption) (metric.Int64ObservableCounter, error) {
+	return mockInt64ObservableCounter{name: name}, nil
+}
+
+func (*meter) Int64ObservableUpDownCounter(name string, _ ...metric.Int64ObservableUpDownCounterOption) (metric.Int64ObservableUpDownCounter, error) {
+	return mockInt64ObservableUpDownCounter{name: name}, nil
+}
+
+func (*meter) Float64ObservableCounter(name string, _ ...metric.Float64ObservableCounterOption) (metric.Float64ObservableCounter, error) {
+	return mockFloat64ObservableCounter{name: name}, nil
+}
+
+func (m *meter) RegisterCallback(f metric.Callback, _ ...metric.Observable) (metric.Registration, error) {
+	m.callbacks = append(m.callbacks, f)
+	return mockRegistration{}, nil
+}
+
+type meterProvider struct {
+	noop.MeterProvider
+
+	meter *meter
+}
+
+func newMeterProvider() *meterProvider {
+	return &meterProvider{meter: &meter{Meter: bindtest.NewMeter()}}
+}
+
+func (p *meterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return p.meter
+}
+
+// observation is an observation of an instrument named name.
+type observation struct {
+	name  string
+	value float64
+	attrs attribute.Set
+}
+
+type observer struct {
+	embedded.Observer
+
+	got []observation
+}
+
+func (o *observer) observe(inst metric.Observable, val float64, opts []metric.ObserveOption) {
+	var name string
+	switch i := inst.(type) {
+	case mockInt64ObservableCounter:
+		name = i.name
+	case mockInt64ObservableUpDownCounter:
+		name = i.name
+	case mockFloat64ObservableCounter:
+		name = i.name
+	}
+	o.got = append(o.got, observation{name: name, value: val, attrs: metric.NewObserveConfig(opts).Attributes()})
+}
+
+func (o *observer) ObserveInt64(inst metric.Int64Observable, val int64, opts ...metric.ObserveOption) {
+	o.observe(inst, float64(val), opts)
+}
+
+func (o *observer) ObserveFloat64(inst metric.Float64Observable, val float64, opts ...metric.ObserveOption) {
+	o.observe(inst, val, opts)
+}
+
+var dbAttrs = []attribute.KeyValue{
+	attribute.String("db.system.name", "postgresql"),
+	attribute.String("db.namespace", "orders"),
+}
+
+func operation(name string, extra ...attribute.KeyValue) attribute.Set {
+	attrs := append([]attribute.KeyValue{bindsql.OperationKey.String(name)}, dbAttrs...)
+	return attribute.NewSet(append(attrs, extra...)...)
+}
+
+func operations(mp *meterProvider) []attribute.Set {
+	got := mp.meter.Float64Measurements("db.client.operation.duration")
+	sets := make([]attribute.Set, len(got))
+	for i := range got {
+		sets[i] = got[i].Attributes
+	}
+	return sets
+}
+
+func TestOpenDB(t *testing.T) {
+	mp := newMeterProvider()
+	db := bindsql.OpenDB(fakeConnector{}, "postgres",
+		bindsql.WithMeterProvider(mp),
+		bindsql.WithNamespace("orders"),
+	)
+	defer db.Close()
+	ctx := t.Context()
+
+	_, err := db.ExecContext(ctx, "INSERT")
+	require.NoError(t, err)
+	rows, err := db.QueryContext(ctx, "SELECT")
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+	_, err = db.ExecContext(ctx, "fail")
+	require.ErrorIs(t, err, queryError{})
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	want := []attribute.Set{
+		operation("connect"),
+		operation("exec"),
+		operation("query"),
+		operation("exec", attribute.String("error.type", "bindsql_test.queryError")),
+		operation("begin"),
+		operation("commit"),
+	}
+	assert.Equal(t, want, operations(mp))
+}
+
+func TestOpen(t *testing.T) {
+	mp := newMeterProvider()
+	db, err := bindsql.Open("bindsql-fake", "dsn",
+		bindsql.WithMeterProvider(mp),
+		bindsql.WithNamespace("orders"),
+		bindsql.WithAttributes(attribute.String("service", "api")),
+	)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.PingContext(t.Context()))
+
+	// Unknown drivers are reported as other_sql.
+	op := func(name string) attribute.Set {
+		return attribute.NewSet(
+			attribute.String("db.system.name", "other_sql"),
+			attribute.String("db.namespace", "orders"),
+			bindsql.OperationKey.String(name),
+			attribute.String("service", "api"),
+		)
+	}
+	assert.Equal(t, []attribute.Set{op("connect"), op("ping")}, operations(mp))
+}
+
+func TestWrapDriverPrepareFallback(t *testing.T) {
+	mp := newMeterProvider()
+	sql.Register("bindsql-legacy", bindsql.WrapDriver(legacyDriver{}, "postgres",
+		bindsql.WithMeterProvider(mp),
+		bindsql.WithNamespace("orders"),
+	))
+	db, err := sql.Open("bindsql-legacy", "dsn")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.ExecContext(t.Context(), "UPDATE")
+	require.NoError(t, err)
+
+	_, err = db.BeginTx(t.Context(), &sql.TxOptions{ReadOnly: true})
+	require.Error(t, err)
+
+	// Exec is skipped by the connection and run as a prepared statement.
+	assert.Equal(t, []attribute.Set{
+		operation("connect"),
+		operation("prepare"),
+		operation("exec"),
+		operation("begin", attribute.String("error.type", "*errors.errorString")),
+	}, operations(mp))
+}
+
+func TestRegisterDBStats(t *testing.T) {
+	mp := newMeterProvider()
+	db := bindsql.OpenDB(fakeConnector{}, "postgres")
+	defer db.Close()
+	db.SetMaxOpenConns(4)
+
+	conn, err := db.Conn(t.Context())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	reg, err := bindsql.RegisterDBStats(db, "postgres",
+		bindsql.WithMeterProvider(mp),
+		bindsql.WithNamespace("orders"),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, reg.Unregister()) }()
+
+	require.Len(t, mp.meter.callbacks, 1)
+	o := new(observer)
+	require.NoError(t, mp.meter.callbacks[0](t.Context(), o))
+
+	pool := attribute.String("db.client.connection.pool.name", "postgres/orders")
+	set := func(extra ...attribute.KeyValue) attribute.Set {
+		return attribute.NewSet(append(append(extra, pool), dbAttrs...)...)
+	}
+	state := attribute.Key("db.client.connection.state")
+	reason := attribute.Key("db.client.connection.close_reason")
+	want := []observation{
+		{name: "db.client.connection.count", value: 0, attrs: set(state.String("idle"))},
+		{name: "db.client.connection.count", value: 1, attrs: set(state.String("used"))},
+		{name: "db.client.connection.max", value: 4, attrs: set()},
+		{name: "db.client.connection.waits", value: 0, attrs: set()},
+		{name: "db.client.connection.wait_duration", value: 0, attrs: set()},
+		{name: "db.client.connection.closed", value: 0, attrs: set(reason.String("max_idle"))},
+		{name: "db.client.connection.closed", value: 0, attrs: set(reason.String("max_idle_time"))},
+		{name: "db.client.connection.closed", value: 0, attrs: set(reason.String("max_lifetime"))},
+	}
+	assert.Equal(t, want, o.got)
+}
+
+func TestRegisterDBStatsPoolName(t *testing.T) {
+	db := bindsql.OpenDB(fakeConnector{}, "postgres")
+	defer db.Close()
+
+	for _, tc := range []struct {
+		name string
+		opts []bindsql.Option
+		want string
+	}{
+		{name: "Default", want: "postgres"},
+		{name: "Namespace", opts: []bindsql.Option{bindsql.WithNamespace("orders")}, want: "postgres/orders"},
+		{name: "WithPoolName", opts: []bindsql.Option{bindsql.WithNamespace("orders"), bindsql.WithPoolName("primary")}, want: "primary"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := newMeterProvider()
+			reg, err := bindsql.RegisterDBStats(db, "postgres", append(tc.opts, bindsql.WithMeterProvider(mp))...)
+			require.NoError(t, err)
+			defer func() { assert.NoError(t, reg.Unregister()) }()
+
+			require.Len(t, mp.meter.callbacks, 1)
+			o := new(observer)
+			require.NoError(t, mp.meter.callbacks[0](t.Context(), o))
+			require.NotEmpty(t, o.got)
+			for _, got := range o.got {
+				v, _ := got.attrs.Value("db.client.connection.pool.name")
+				assert.Equal(t, tc.want, v.AsString(), got.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package bindsql
+
+import (
+	"github.com/MrAlias/bind"
+	"github.com/MrAlias/bind/bindsemconv"
+	"github.com/MrAlias/bind/internal/instconfig"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ScopeName is the instrumentation scope name of the Meter used to create
+// instruments.
+const ScopeName = "github.com/MrAlias/bind/bindsql"
+
+// Option configures a wrapped driver, connector, or the export of database
+// statistics.
+type Option interface {
+	instconfig.Option[config]
+}
+
+type config struct {
+	instconfig.Config
+
+	namespace string
+	poolName  string
+}
+
+func newConfig(opts []Option) config {
+	return instconfig.New(config{}, opts)
+}
+
+// meter returns a Meter bound to the database attributes of driverName and
+// the configured namespace and attributes.
+func (c config) meter(driverName string) metric.Meter {
+	m := c.Meter(ScopeName, metric.WithSchemaURL(bindsemconv.SchemaURL))
+	return bind.Meter(m, bindsemconv.DB(driverName, c.namespace)...)
+}
+
+// pool returns the connection pool name of a database of driverName.
+func (c config) pool(driverName string) string {
+	switch {
+	case c.poolName != "":
+		return c.poolName
+	case c.namespace != "":
+		return driverName + "/" + c.namespace
+	default:
+		return driverName
+	}
+}
+
+// WithMeterProvider returns an [Option] that uses mp to create instruments.
+// By default, the global MeterProvider is used.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return instconfig.WithMeterProvider[config](mp)
+}
+
+// WithNamespace returns an [Option] that binds the db.namespace attribute,
+// e.g. the database name, to all instruments. By default, no namespace is
+// bound.
+func WithNamespace(namespace string) Option {
+	return instconfig.OptionFunc[config](func(c *config) {
+		c.namespace = namespace
+	})
+}
+
+// WithPoolName returns an [Option] that sets the
+// db.client.connection.pool.name attribute bound to the instruments of
+// [RegisterDBStats]. By default, the driver name followed by "/" and the
+// namespace, if configured, is used.
+func WithPoolName(name string) Option {
+	return instconfig.OptionFunc[config](func(c *config) {
+		c.poolName = name
+	})
+}
+
+// WithAttributes returns an [Option] that binds attrs to all instruments in
+// addition to the database attributes.
+func WithAttributes(attrs ...attribute.KeyValue) Option {
+	return instconfig.WithAttributes[config](attrs)
+}
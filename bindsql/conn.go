@@ -0,0 +1,269 @@
+package bindsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"time"
+)
+
+// errIsolation is returned when beginning a transaction with options that
+// are not supported by a driver.
+var errIsolation = errors.New("bindsql: driver does not support non-default transaction options")
+
+// conn is a driver.Conn that records operations.
+//
+// It implements all optional connection interfaces and falls back to the
+// behavior of [database/sql] for those the wrapped connection does not
+// implement.
+type conn struct {
+	driver.Conn
+
+	ops *operations
+}
+
+var (
+	_ driver.Conn               = (*conn)(nil)
+	_ driver.ConnPrepareContext = (*conn)(nil)
+	_ driver.ConnBeginTx        = (*conn)(nil)
+	_ driver.ExecerContext      = (*conn)(nil)
+	_ driver.QueryerContext     = (*conn)(nil)
+	_ driver.Pinger             = (*conn)(nil)
+	_ driver.SessionResetter    = (*conn)(nil)
+	_ driver.Validator          = (*conn)(nil)
+	_ driver.NamedValueChecker  = (*conn)(nil)
+)
+
+func wrapConn(c driver.Conn, ops *operations) *conn {
+	return &conn{Conn: c, ops: ops}
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	start := time.Now()
+	var (
+		s   driver.Stmt
+		err error
+	)
+	if p, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		s, err = p.PrepareContext(ctx, query)
+	} else if err = ctx.Err(); err == nil {
+		s, err = c.Conn.Prepare(query)
+	}
+	c.ops.record(ctx, opPrepare, start, err)
+	if err != nil {
+		return nil, err
+	}
+	return &stmt{Stmt: s, conn: c}, nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	start := time.Now()
+	var (
+		t   driver.Tx
+		err error
+	)
+	switch b, ok := c.Conn.(driver.ConnBeginTx); {
+	case ok:
+		t, err = b.BeginTx(ctx, opts)
+	case opts.Isolation != driver.IsolationLevel(0) || opts.ReadOnly:
+		err = errIsolation
+	default:
+		if err = ctx.Err(); err == nil {
+			t, err = c.Conn.Begin() //nolint:staticcheck // Fallback for drivers without ConnBeginTx.
+		}
+	}
+	c.ops.record(ctx, opBegin, start, err)
+	if err != nil {
+		return nil, err
+	}
+	return &tx{Tx: t, ctx: ctx, ops: c.ops}, nil
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	var (
+		r   driver.Result
+		err error
+	)
+	switch e := c.Conn.(type) {
+	case driver.ExecerContext:
+		r, err = e.ExecContext(ctx, query, args)
+	case driver.Execer: //nolint:staticcheck // Fallback for drivers without ExecerContext.
+		var vals []driver.Value
+		if vals, err = values(args); err == nil {
+			if err = ctx.Err(); err == nil {
+				r, err = e.Exec(query, vals)
+			}
+		}
+	default:
+		// Let database/sql prepare a statement instead.
+		return nil, driver.ErrSkip
+	}
+	c.ops.record(ctx, opExec, start, err)
+	return r, err
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	var (
+		r   driver.Rows
+		err error
+	)
+	switch q := c.Conn.(type) {
+	case driver.QueryerContext:
+		r, err = q.QueryContext(ctx, query, args)
+	case driver.Queryer: //nolint:staticcheck // Fallback for drivers without QueryerContext.
+		var vals []driver.Value
+		if vals, err = values(args); err == nil {
+			if err = ctx.Err(); err == nil {
+				r, err = q.Query(query, vals)
+			}
+		}
+	default:
+		// Let database/sql prepare a statement instead.
+		return nil, driver.ErrSkip
+	}
+	c.ops.record(ctx, opQuery, start, err)
+	return r, err
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	p, ok := c.Conn.(driver.Pinger)
+	if !ok {
+		return nil
+	}
+	start := time.Now()
+	err := p.Ping(ctx)
+	c.ops.record(ctx, opPing, start, err)
+	return err
+}
+
+func (c *conn) ResetSession(ctx context.Context) error {
+	if r, ok := c.Conn.(driver.SessionResetter); ok {
+		return r.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *conn) IsValid() bool {
+	if v, ok := c.Conn.(driver.Validator); ok {
+		return v.IsValid()
+	}
+	return true
+}
+
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	if n, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return n.CheckNamedValue(nv)
+	}
+	// Use the default conversion of database/sql.
+	return driver.ErrSkip
+}
+
+// values returns the values of args. Named arguments are not supported by
+// the deprecated driver interfaces.
+func values(args []driver.NamedValue) ([]driver.Value, error) {
+	vals := make([]driver.Value, len(args))
+	for i, a := range args {
+		if a.Name != "" {
+			return nil, errors.New("bindsql: driver does not support named arguments")
+		}
+		vals[i] = a.Value
+	}
+	return vals, nil
+}
+
+// stmt is a driver.Stmt that records operations.
+type stmt struct {
+	driver.Stmt
+
+	conn *conn
+}
+
+var (
+	_ driver.Stmt              = (*stmt)(nil)
+	_ driver.StmtExecContext   = (*stmt)(nil)
+	_ driver.StmtQueryContext  = (*stmt)(nil)
+	_ driver.NamedValueChecker = (*stmt)(nil)
+)
+
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	var (
+		r   driver.Result
+		err error
+	)
+	if e, ok := s.Stmt.(driver.StmtExecContext); ok {
+		r, err = e.ExecContext(ctx, args)
+	} else {
+		var vals []driver.Value
+		if vals, err = values(args); err == nil {
+			if err = ctx.Err(); err == nil {
+				r, err = s.Stmt.Exec(vals) //nolint:staticcheck // Fallback for drivers without StmtExecContext.
+			}
+		}
+	}
+	s.conn.ops.record(ctx, opExec, start, err)
+	return r, err
+}
+
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	var (
+		r   driver.Rows
+		err error
+	)
+	if q, ok := s.Stmt.(driver.StmtQueryContext); ok {
+		r, err = q.QueryContext(ctx, args)
+	} else {
+		var vals []driver.Value
+		if vals, err = values(args); err == nil {
+			if err = ctx.Err(); err == nil {
+				r, err = s.Stmt.Query(vals) //nolint:staticcheck // Fallback for drivers without StmtQueryContext.
+			}
+		}
+	}
+	s.conn.ops.record(ctx, opQuery, start, err)
+	return r, err
+}
+
+// CheckNamedValue checks nv using the wrapped statement or, if it does not
+// implement [driver.NamedValueChecker], its connection. The deprecated
+// [driver.ColumnConverter] is not used.
+func (s *stmt) CheckNamedValue(nv *driver.NamedValue) error {
+	if n, ok := s.Stmt.(driver.NamedValueChecker); ok {
+		return n.CheckNamedValue(nv)
+	}
+	return s.conn.CheckNamedValue(nv)
+}
+
+// tx is a driver.Tx that records operations.
+type tx struct {
+	driver.Tx
+
+	// ctx is the context the transaction was begun with.
+	ctx context.Context
+	ops *operations
+}
+
+func (t *tx) Commit() error {
+	start := time.Now()
+	err := t.Tx.Commit()
+	t.ops.record(t.ctx, opCommit, start, err)
+	return err
+}
+
+func (t *tx) Rollback() error {
+	start := time.Now()
+	err := t.Tx.Rollback()
+	t.ops.record(t.ctx, opRollback, start, err)
+	return err
+}
@@ -0,0 +1,27 @@
+/*
+Package bindsql provides [database/sql] driver wrappers that record database
+client metrics using bound instruments.
+
+The instruments of a wrapped driver or connector are bound to the
+db.system.name and db.namespace attributes of the database once, when it is
+wrapped, and to the name of each driver operation (e.g. "query" or "commit")
+using [OperationKey]. Only the type of error is added when an operation
+fails.
+
+Example usage:
+
+	db, err := bindsql.Open("postgres", dsn, bindsql.WithNamespace("orders"))
+	if err != nil {
+		// Handle error.
+	}
+	reg, err := bindsql.RegisterDBStats(db, "postgres", bindsql.WithNamespace("orders"))
+	if err != nil {
+		// Handle error.
+	}
+	defer reg.Unregister()
+
+The db.client.operation.duration metric of the OpenTelemetry semantic
+conventions (see [bindsemconv.Version]) is recorded for all operations. The
+[sql.DBStats] of a database are exported by [RegisterDBStats].
+*/
+package bindsql
@@ -0,0 +1,145 @@
+package bindsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"time"
+)
+
+// WrapDriver returns d wrapped to record the operations of all connections
+// it opens. The instruments are bound to the database attributes of
+// driverName, the name d is registered with (see [sql.Register]), and the
+// configured namespace once for all connections.
+func WrapDriver(d driver.Driver, driverName string, opts ...Option) driver.Driver {
+	return &wrappedDriver{Driver: d, ops: newOperations(newConfig(opts).meter(driverName))}
+}
+
+// WrapConnector returns c wrapped to record the operations of all
+// connections it opens. The instruments are bound to the database attributes
+// of driverName, the name of the driver of c (see [sql.Register]), and the
+// configured namespace once for the connector.
+func WrapConnector(c driver.Connector, driverName string, opts ...Option) driver.Connector {
+	ops := newOperations(newConfig(opts).meter(driverName))
+	return &connector{
+		Connector: c,
+		driver:    &wrappedDriver{Driver: c.Driver(), ops: ops},
+		ops:       ops,
+	}
+}
+
+// Open opens a database of the registered driver driverName that records
+// the operations of all its connections (see [WrapConnector]). The dsn is
+// passed to the driver as is.
+func Open(driverName, dsn string, opts ...Option) (*sql.DB, error) {
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	d := db.Driver()
+	if err := db.Close(); err != nil {
+		return nil, err
+	}
+
+	var c driver.Connector
+	if dc, ok := d.(driver.DriverContext); ok {
+		c, err = dc.OpenConnector(dsn)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		c = dsnConnector{dsn: dsn, driver: d}
+	}
+	return OpenDB(c, driverName, opts...), nil
+}
+
+// OpenDB opens a database using c that records the operations of all its
+// connections (see [WrapConnector]).
+func OpenDB(c driver.Connector, driverName string, opts ...Option) *sql.DB {
+	return sql.OpenDB(WrapConnector(c, driverName, opts...))
+}
+
+// wrappedDriver is a driver.Driver that records operations.
+type wrappedDriver struct {
+	driver.Driver
+
+	ops *operations
+}
+
+var (
+	_ driver.Driver        = (*wrappedDriver)(nil)
+	_ driver.DriverContext = (*wrappedDriver)(nil)
+)
+
+func (d *wrappedDriver) Open(name string) (driver.Conn, error) {
+	start := time.Now()
+	c, err := d.Driver.Open(name)
+	d.ops.record(context.Background(), opConnect, start, err)
+	if err != nil {
+		return nil, err
+	}
+	return wrapConn(c, d.ops), nil
+}
+
+func (d *wrappedDriver) OpenConnector(name string) (driver.Connector, error) {
+	var c driver.Connector = dsnConnector{dsn: name, driver: d.Driver}
+	if dc, ok := d.Driver.(driver.DriverContext); ok {
+		var err error
+		if c, err = dc.OpenConnector(name); err != nil {
+			return nil, err
+		}
+	}
+	return &connector{Connector: c, driver: d, ops: d.ops}, nil
+}
+
+// connector is a driver.Connector that records operations.
+type connector struct {
+	driver.Connector
+
+	driver *wrappedDriver
+	ops    *operations
+}
+
+var (
+	_ driver.Connector = (*connector)(nil)
+	_ io.Closer        = (*connector)(nil)
+)
+
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	start := time.Now()
+	conn, err := c.Connector.Connect(ctx)
+	c.ops.record(ctx, opConnect, start, err)
+	if err != nil {
+		return nil, err
+	}
+	return wrapConn(conn, c.ops), nil
+}
+
+func (c *connector) Driver() driver.Driver {
+	return c.driver
+}
+
+// Close closes the underlying connector if it implements [io.Closer]. It is
+// called when the database of the connector is closed.
+func (c *connector) Close() error {
+	if cl, ok := c.Connector.(io.Closer); ok {
+		return cl.Close()
+	}
+	return nil
+}
+
+// dsnConnector is the driver.Connector of drivers that do not implement
+// driver.DriverContext.
+type dsnConnector struct {
+	dsn    string
+	driver driver.Driver
+}
+
+func (c dsnConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c dsnConnector) Driver() driver.Driver {
+	return c.driver
+}
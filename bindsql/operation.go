@@ -0,0 +1,105 @@
+package bindsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MrAlias/bind"
+	"github.com/MrAlias/bind/internal/instconfig"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+)
+
+// OperationKey is the attribute key of the database/sql driver operation
+// (e.g. "query" or "commit") the duration of an operation is recorded for.
+//
+// The db.operation.name attribute of the semantic conventions is the
+// operation of the database, e.g. "SELECT", which a driver wrapper does not
+// know. This package specific key is used instead so its values are not
+// mistaken for database operations.
+const OperationKey = attribute.Key("bindsql.operation")
+
+// operation is a database operation.
+type operation int
+
+// Database operations.
+const (
+	opConnect operation = iota
+	opPing
+	opPrepare
+	opExec
+	opQuery
+	opBegin
+	opCommit
+	opRollback
+
+	numOps
+)
+
+var opNames = [numOps]string{
+	opConnect:  "connect",
+	opPing:     "ping",
+	opPrepare:  "prepare",
+	opExec:     "exec",
+	opQuery:    "query",
+	opBegin:    "begin",
+	opCommit:   "commit",
+	opRollback: "rollback",
+}
+
+// durationBoundaries are the explicit bucket boundaries advised by the
+// semantic conventions for db.client.operation.duration.
+var durationBoundaries = []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 10}
+
+// operations records the duration of database operations.
+type operations struct {
+	// durations are bound to the name of each operation.
+	durations [numOps]metric.Float64Histogram
+}
+
+func newOperations(m metric.Meter) *operations {
+	h := instconfig.Float64Histogram(m,
+		"db.client.operation.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of database client operations."),
+		metric.WithExplicitBucketBoundaries(durationBoundaries...),
+	)
+
+	ops := new(operations)
+	for op, name := range opNames {
+		ops.durations[op] = bind.Float64Histogram(h, OperationKey.String(name))
+	}
+	return ops
+}
+
+// record records the duration of op started at start that completed with
+// err. Operations skipped by the driver (see [driver.ErrSkip]) are not
+// recorded.
+func (o *operations) record(ctx context.Context, op operation, start time.Time, err error) {
+	d := time.Since(start).Seconds()
+	switch {
+	case err == nil:
+		o.durations[op].Record(ctx, d)
+	case errors.Is(err, driver.ErrSkip):
+	default:
+		o.durations[op].Record(ctx, d, metric.WithAttributes(semconv.ErrorTypeKey.String(errorType(err))))
+	}
+}
+
+// errorType returns the error.type of err.
+func errorType(err error) string {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, driver.ErrBadConn):
+		return "bad_connection"
+	default:
+		return fmt.Sprintf("%T", err)
+	}
+}
@@ -0,0 +1,97 @@
+package bindsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MrAlias/bind"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+)
+
+// closeReasonKey is the attribute key of the reason connections were closed.
+const closeReasonKey = attribute.Key("db.client.connection.close_reason")
+
+var (
+	idleOpt = metric.WithAttributeSet(attribute.NewSet(semconv.DBClientConnectionStateIdle))
+	usedOpt = metric.WithAttributeSet(attribute.NewSet(semconv.DBClientConnectionStateUsed))
+
+	maxIdleOpt     = metric.WithAttributeSet(attribute.NewSet(closeReasonKey.String("max_idle")))
+	maxIdleTimeOpt = metric.WithAttributeSet(attribute.NewSet(closeReasonKey.String("max_idle_time")))
+	maxLifetimeOpt = metric.WithAttributeSet(attribute.NewSet(closeReasonKey.String("max_lifetime")))
+)
+
+// RegisterDBStats registers observable instruments that export the
+// [sql.DBStats] of db. The instruments are bound to the database attributes
+// of driverName, the name of the driver of db, and the configured namespace.
+// They are also bound to the db.client.connection.pool.name of db (see
+// [WithPoolName]).
+//
+// The following metrics are exported:
+//
+//   - db.client.connection.count: the number of connections by
+//     db.client.connection.state ("idle" or "used")
+//   - db.client.connection.max: the maximum number of open connections
+//   - db.client.connection.waits: the number of times a connection was
+//     waited for
+//   - db.client.connection.wait_duration: the total time waited for a
+//     connection
+//   - db.client.connection.closed: the number of connections closed by
+//     db.client.connection.close_reason ("max_idle", "max_idle_time", or
+//     "max_lifetime")
+//
+// The last three are not part of the semantic conventions. The returned
+// [metric.Registration] needs to be unregistered when db is closed.
+func RegisterDBStats(db *sql.DB, driverName string, opts ...Option) (metric.Registration, error) {
+	c := newConfig(opts)
+	m := bind.Meter(c.meter(driverName), semconv.DBClientConnectionPoolName(c.pool(driverName)))
+
+	count, err := m.Int64ObservableUpDownCounter(
+		"db.client.connection.count",
+		metric.WithUnit("{connection}"),
+		metric.WithDescription("Number of connections by state."),
+	)
+	errs := err
+	maxOpen, err := m.Int64ObservableUpDownCounter(
+		"db.client.connection.max",
+		metric.WithUnit("{connection}"),
+		metric.WithDescription("Maximum number of open connections allowed."),
+	)
+	errs = errors.Join(errs, err)
+	waits, err := m.Int64ObservableCounter(
+		"db.client.connection.waits",
+		metric.WithUnit("{wait}"),
+		metric.WithDescription("Number of times a connection was waited for."),
+	)
+	errs = errors.Join(errs, err)
+	waitDuration, err := m.Float64ObservableCounter(
+		"db.client.connection.wait_duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Total time waited for connections."),
+	)
+	errs = errors.Join(errs, err)
+	closed, err := m.Int64ObservableCounter(
+		"db.client.connection.closed",
+		metric.WithUnit("{connection}"),
+		metric.WithDescription("Number of connections closed by reason."),
+	)
+	errs = errors.Join(errs, err)
+	if errs != nil {
+		return nil, errs
+	}
+
+	return m.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		s := db.Stats()
+		o.ObserveInt64(count, int64(s.Idle), idleOpt)
+		o.ObserveInt64(count, int64(s.InUse), usedOpt)
+		o.ObserveInt64(maxOpen, int64(s.MaxOpenConnections))
+		o.ObserveInt64(waits, s.WaitCount)
+		o.ObserveFloat64(waitDuration, s.WaitDuration.Seconds())
+		o.ObserveInt64(closed, s.MaxIdleClosed, maxIdleOpt)
+		o.ObserveInt64(closed, s.MaxIdleTimeClosed, maxIdleTimeOpt)
+		o.ObserveInt64(closed, s.MaxLifetimeClosed, maxLifetimeOpt)
+		return nil
+	}, count, maxOpen, waits, waitDuration, closed)
+}
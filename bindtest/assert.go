@@ -0,0 +1,93 @@
+package bindtest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MrAlias/bind"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// TB is the subset of [testing.TB] used by the assertion helpers.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// AssertMeasurements reports an error to t and returns false if got does not
+// contain exactly the measurements in want, in the same order. Measurements
+// are compared by value and attributes. Contexts are not compared.
+func AssertMeasurements[N int64 | float64](t TB, want, got []Measurement[N]) bool {
+	t.Helper()
+
+	equal := len(want) == len(got)
+	for i := 0; equal && i < len(want); i++ {
+		equal = want[i].Value == got[i].Value &&
+			want[i].Attributes.Equals(&got[i].Attributes)
+	}
+	if !equal {
+		t.Errorf("unexpected measurements:\nwant: %s\ngot:  %s", format(want), format(got))
+	}
+	return equal
+}
+
+// AssertRecorded reports an error to t and returns false if the measurements
+// recorded by inst are not exactly want (see [AssertMeasurements]).
+//
+// The inst needs to be a recording instrument from this package, or a bound
+// instrument wrapping one. For a bound instrument, the measurements of the
+// wrapped recording instrument are compared. These include measurements made
+// with any other instrument bound to the same recording instrument.
+func AssertRecorded[N int64 | float64](t TB, inst any, want ...Measurement[N]) bool {
+	t.Helper()
+
+	r, ok := unwrap(inst).(Recorder[N])
+	if !ok {
+		var zero N
+		t.Errorf("%T does not record %T measurements", inst, zero)
+		return false
+	}
+	return AssertMeasurements(t, want, r.Measurements())
+}
+
+// unwrap returns the instrument wrapped by inst if inst is bound. Otherwise,
+// inst is returned.
+func unwrap(inst any) any {
+	switch i := inst.(type) {
+	case metric.Int64Counter:
+		inst, _ = bind.Unwrap(i)
+	case metric.Int64UpDownCounter:
+		inst, _ = bind.Unwrap(i)
+	case metric.Int64Histogram:
+		inst, _ = bind.Unwrap(i)
+	case metric.Int64Gauge:
+		inst, _ = bind.Unwrap(i)
+	case metric.Float64Counter:
+		inst, _ = bind.Unwrap(i)
+	case metric.Float64UpDownCounter:
+		inst, _ = bind.Unwrap(i)
+	case metric.Float64Histogram:
+		inst, _ = bind.Unwrap(i)
+	case metric.Float64Gauge:
+		inst, _ = bind.Unwrap(i)
+	}
+	return inst
+}
+
+func format[N int64 | float64](m []Measurement[N]) string {
+	parts := make([]string, len(m))
+	for i, meas := range m {
+		parts[i] = fmt.Sprintf("%v {%s}", meas.Value, formatAttrs(meas.Attributes))
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+func formatAttrs(set attribute.Set) string {
+	parts := make([]string, 0, set.Len())
+	for iter := set.Iter(); iter.Next(); {
+		kv := iter.Attribute()
+		parts = append(parts, fmt.Sprintf("%s=%s", kv.Key, kv.Value.Emit()))
+	}
+	return strings.Join(parts, ", ")
+}
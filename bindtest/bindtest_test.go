@@ -0,0 +1,150 @@
+package bindtest_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/MrAlias/bind/bindtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	userAlice = attribute.String("user", "alice")
+	userID    = attribute.Int("id", 12345)
+)
+
+// fakeTB records errors reported by assertion helpers.
+type fakeTB struct {
+	errs []string
+}
+
+func (*fakeTB) Helper() {}
+
+func (t *fakeTB) Errorf(format string, args ...any) {
+	t.errs = append(t.errs, fmt.Sprintf(format, args...))
+}
+
+type ctxKey struct{}
+
+func testInstrument[T any, N int64 | float64](inst T, measure func(T, context.Context, N, ...attribute.KeyValue)) func(*testing.T) {
+	return func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+		bound := bind.Bind(inst, userAlice)
+		measure(bound, ctx, 1, userID)
+		measure(inst, ctx, 2)
+
+		bindtest.AssertRecorded(t, inst,
+			bindtest.NewMeasurement[N](1, userAlice, userID),
+			bindtest.NewMeasurement[N](2),
+		)
+		bindtest.AssertRecorded(t, bound,
+			bindtest.NewMeasurement[N](1, userAlice, userID),
+			bindtest.NewMeasurement[N](2),
+		)
+
+		r := any(inst).(bindtest.Recorder[N])
+		got := r.Measurements()
+		require.Len(t, got, 2)
+		assert.Equal(t, ctx, got[0].Context, "recorded context")
+	}
+}
+
+func add[N int64 | float64, T interface {
+	Add(context.Context, N, ...metric.AddOption)
+}](i T, ctx context.Context, v N, attrs ...attribute.KeyValue) {
+	i.Add(ctx, v, metric.WithAttributes(attrs...))
+}
+
+func record[N int64 | float64, T interface {
+	Record(context.Context, N, ...metric.RecordOption)
+}](i T, ctx context.Context, v N, attrs ...attribute.KeyValue) {
+	i.Record(ctx, v, metric.WithAttributes(attrs...))
+}
+
+func TestInstruments(t *testing.T) {
+	t.Run("Int64Counter", testInstrument[metric.Int64Counter](&bindtest.Int64Counter{}, add[int64]))
+	t.Run("Int64UpDownCounter", testInstrument[metric.Int64UpDownCounter](&bindtest.Int64UpDownCounter{}, add[int64]))
+	t.Run("Int64Histogram", testInstrument[metric.Int64Histogram](&bindtest.Int64Histogram{}, record[int64]))
+	t.Run("Int64Gauge", testInstrument[metric.Int64Gauge](&bindtest.Int64Gauge{}, record[int64]))
+	t.Run("Float64Counter", testInstrument[metric.Float64Counter](&bindtest.Float64Counter{}, add[float64]))
+	t.Run("Float64UpDownCounter", testInstrument[metric.Float64UpDownCounter](&bindtest.Float64UpDownCounter{}, add[float64]))
+	t.Run("Float64Histogram", testInstrument[metric.Float64Histogram](&bindtest.Float64Histogram{}, record[float64]))
+	t.Run("Float64Gauge", testInstrument[metric.Float64Gauge](&bindtest.Float64Gauge{}, record[float64]))
+}
+
+func TestInstrumentResetAndEnabled(t *testing.T) {
+	c := &bindtest.Float64Counter{}
+	ctx := context.Background()
+	assert.True(t, c.Enabled(ctx), "enabled by default")
+
+	c.SetEnabled(false)
+	assert.False(t, c.Enabled(ctx), "disabled")
+
+	c.Add(ctx, 1)
+	require.Len(t, c.Measurements(), 1)
+	c.Reset()
+	assert.Empty(t, c.Measurements(), "reset")
+}
+
+func TestMeter(t *testing.T) {
+	m := bindtest.NewMeter()
+	meter := bind.Meter(m, userAlice)
+
+	c0, err := meter.Int64Counter("requests")
+	require.NoError(t, err)
+	c1, err := meter.Int64Counter("requests")
+	require.NoError(t, err)
+	h, err := meter.Float64Histogram("latency")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	c0.Add(ctx, 1)
+	c1.Add(ctx, 2, metric.WithAttributes(userID))
+	h.Record(ctx, 0.5)
+
+	bindtest.AssertMeasurements(t, []bindtest.Measurement[int64]{
+		bindtest.NewMeasurement[int64](1, userAlice),
+		bindtest.NewMeasurement[int64](2, userAlice, userID),
+	}, m.Int64Measurements("requests"))
+	bindtest.AssertMeasurements(t, []bindtest.Measurement[float64]{
+		bindtest.NewMeasurement(0.5, userAlice),
+	}, m.Float64Measurements("latency"))
+
+	assert.Nil(t, m.Float64Measurements("requests"), "wrong number type")
+	assert.Nil(t, m.Int64Measurements("unknown"), "unknown instrument")
+
+	_, err = meter.Float64Counter("requests")
+	assert.Error(t, err, "different instrument kind with same name")
+}
+
+func TestAssertMeasurementsFailure(t *testing.T) {
+	tb := &fakeTB{}
+	got := []bindtest.Measurement[int64]{bindtest.NewMeasurement[int64](1, userAlice)}
+
+	assert.True(t, bindtest.AssertMeasurements(tb, got, got))
+	assert.Empty(t, tb.errs)
+
+	want := []bindtest.Measurement[int64]{bindtest.NewMeasurement[int64](1, userID)}
+	assert.False(t, bindtest.AssertMeasurements(tb, want, got), "different attributes")
+
+	want = []bindtest.Measurement[int64]{bindtest.NewMeasurement[int64](2, userAlice)}
+	assert.False(t, bindtest.AssertMeasurements(tb, want, got), "different value")
+
+	assert.False(t, bindtest.AssertMeasurements(tb, nil, got), "different length")
+
+	require.Len(t, tb.errs, 3)
+	assert.Contains(t, tb.errs[0], "want: [1 {id=12345}]")
+	assert.Contains(t, tb.errs[0], "got:  [1 {user=alice}]")
+}
+
+func TestAssertRecordedWrongType(t *testing.T) {
+	tb := &fakeTB{}
+	assert.False(t, bindtest.AssertRecorded[float64](tb, &bindtest.Int64Counter{}))
+	assert.Len(t, tb.errs, 1)
+}
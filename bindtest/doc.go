@@ -0,0 +1,22 @@
+/*
+Package bindtest provides recording OpenTelemetry metric instruments for
+testing code that uses bound instruments.
+
+The recording instruments capture the value, attributes, and context of every
+measurement made with them. The zero value of a recording instrument is ready
+to use, or instruments can be created by name with a [Meter]. When wrapped by a bound instrument, the recorded
+attributes are the bound attributes merged with all other attributes of the
+measurement.
+
+Example usage:
+
+	meter := bindtest.NewMeter()
+	counter, _ := bind.Meter(meter, attribute.String("user", "Alice")).Int64Counter("requests")
+
+	counter.Add(ctx, 1)
+
+	bindtest.AssertRecorded(t, counter,
+		bindtest.NewMeasurement[int64](1, attribute.String("user", "Alice")),
+	)
+*/
+package bindtest
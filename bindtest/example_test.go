@@ -0,0 +1,24 @@
+package bindtest_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MrAlias/bind"
+	"github.com/MrAlias/bind/bindtest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func Example() {
+	counter := &bindtest.Int64Counter{}
+	bound := bind.Int64Counter(counter, attribute.String("user", "Alice"))
+
+	bound.Add(context.Background(), 1, metric.WithAttributes(attribute.Int("id", 1)))
+
+	for _, m := range counter.Measurements() {
+		fmt.Println(m.Value, m.Attributes.Encoded(attribute.DefaultEncoder()))
+	}
+	// Output:
+	// 1 id=1,user=Alice
+}
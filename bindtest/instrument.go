@@ -0,0 +1,160 @@
+package bindtest
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+// Int64Counter is a recording [metric.Int64Counter].
+type Int64Counter struct {
+	embedded.Int64Counter
+	recorder[int64]
+
+	// Name is the name of the instrument.
+	Name string
+}
+
+var (
+	_ metric.Int64Counter = (*Int64Counter)(nil)
+	_ Recorder[int64]     = (*Int64Counter)(nil)
+)
+
+// Add records a change to the counter.
+func (i *Int64Counter) Add(ctx context.Context, incr int64, opts ...metric.AddOption) {
+	i.record(ctx, incr, metric.NewAddConfig(opts).Attributes())
+}
+
+// Int64UpDownCounter is a recording [metric.Int64UpDownCounter].
+type Int64UpDownCounter struct {
+	embedded.Int64UpDownCounter
+	recorder[int64]
+
+	// Name is the name of the instrument.
+	Name string
+}
+
+var (
+	_ metric.Int64UpDownCounter = (*Int64UpDownCounter)(nil)
+	_ Recorder[int64]           = (*Int64UpDownCounter)(nil)
+)
+
+// Add records a change to the counter.
+func (i *Int64UpDownCounter) Add(ctx context.Context, incr int64, opts ...metric.AddOption) {
+	i.record(ctx, incr, metric.NewAddConfig(opts).Attributes())
+}
+
+// Int64Histogram is a recording [metric.Int64Histogram].
+type Int64Histogram struct {
+	embedded.Int64Histogram
+	recorder[int64]
+
+	// Name is the name of the instrument.
+	Name string
+}
+
+var (
+	_ metric.Int64Histogram = (*Int64Histogram)(nil)
+	_ Recorder[int64]       = (*Int64Histogram)(nil)
+)
+
+// Record records a value of the histogram.
+func (i *Int64Histogram) Record(ctx context.Context, value int64, opts ...metric.RecordOption) {
+	i.record(ctx, value, metric.NewRecordConfig(opts).Attributes())
+}
+
+// Int64Gauge is a recording [metric.Int64Gauge].
+type Int64Gauge struct {
+	embedded.Int64Gauge
+	recorder[int64]
+
+	// Name is the name of the instrument.
+	Name string
+}
+
+var (
+	_ metric.Int64Gauge = (*Int64Gauge)(nil)
+	_ Recorder[int64]   = (*Int64Gauge)(nil)
+)
+
+// Record records the instantaneous value.
+func (i *Int64Gauge) Record(ctx context.Context, value int64, opts ...metric.RecordOption) {
+	i.record(ctx, value, metric.NewRecordConfig(opts).Attributes())
+}
+
+// Float64Counter is a recording [metric.Float64Counter].
+type Float64Counter struct {
+	embedded.Float64Counter
+	recorder[float64]
+
+	// Name is the name of the instrument.
+	Name string
+}
+
+var (
+	_ metric.Float64Counter = (*Float64Counter)(nil)
+	_ Recorder[float64]     = (*Float64Counter)(nil)
+)
+
+// Add records a change to the counter.
+func (i *Float64Counter) Add(ctx context.Context, incr float64, opts ...metric.AddOption) {
+	i.record(ctx, incr, metric.NewAddConfig(opts).Attributes())
+}
+
+// Float64UpDownCounter is a recording [metric.Float64UpDownCounter].
+type Float64UpDownCounter struct {
+	embedded.Float64UpDownCounter
+	recorder[float64]
+
+	// Name is the name of the instrument.
+	Name string
+}
+
+var (
+	_ metric.Float64UpDownCounter = (*Float64UpDownCounter)(nil)
+	_ Recorder[float64]           = (*Float64UpDownCounter)(nil)
+)
+
+// Add records a change to the counter.
+func (i *Float64UpDownCounter) Add(ctx context.Context, incr float64, opts ...metric.AddOption) {
+	i.record(ctx, incr, metric.NewAddConfig(opts).Attributes())
+}
+
+// Float64Histogram is a recording [metric.Float64Histogram].
+type Float64Histogram struct {
+	embedded.Float64Histogram
+	recorder[float64]
+
+	// Name is the name of the instrument.
+	Name string
+}
+
+var (
+	_ metric.Float64Histogram = (*Float64Histogram)(nil)
+	_ Recorder[float64]       = (*Float64Histogram)(nil)
+)
+
+// Record records a value of the histogram.
+func (i *Float64Histogram) Record(ctx context.Context, value float64, opts ...metric.RecordOption) {
+	i.record(ctx, value, metric.NewRecordConfig(opts).Attributes())
+}
+
+// Float64Gauge is a recording [metric.Float64Gauge].
+type Float64Gauge struct {
+	embedded.Float64Gauge
+	recorder[float64]
+
+	// Name is the name of the instrument.
+	Name string
+}
+
+var (
+	_ metric.Float64Gauge = (*Float64Gauge)(nil)
+	_ Recorder[float64]   = (*Float64Gauge)(nil)
+)
+
+// Record records the instantaneous value.
+func (i *Float64Gauge) Record(ctx context.Context, value float64, opts ...metric.RecordOption) {
+	i.record(ctx, value, metric.NewRecordConfig(opts).Attributes())
+}
@@ -0,0 +1,88 @@
+package bindtest
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Measurement is a measurement recorded by an instrument.
+type Measurement[N int64 | float64] struct {
+	// Context is the context the measurement was made with.
+	Context context.Context
+	// Value is the measured value.
+	Value N
+	// Attributes are the attributes of the measurement. For a bound
+	// instrument, these are the bound attributes merged with all other
+	// attributes the measurement was made with.
+	Attributes attribute.Set
+}
+
+// NewMeasurement returns a [Measurement] of value with attrs. It is intended
+// to be used to define expected measurements.
+func NewMeasurement[N int64 | float64](value N, attrs ...attribute.KeyValue) Measurement[N] {
+	// NewSet sorts passed attributes. Copy to avoid side effect.
+	cp := make([]attribute.KeyValue, len(attrs))
+	copy(cp, attrs)
+	return Measurement[N]{Value: value, Attributes: attribute.NewSet(cp...)}
+}
+
+// Recorder is implemented by all recording instruments.
+type Recorder[N int64 | float64] interface {
+	// Measurements returns a copy of all recorded measurements in the order
+	// they were made.
+	Measurements() []Measurement[N]
+}
+
+// recorder records measurements. The zero value is ready to use and is
+// enabled.
+type recorder[N int64 | float64] struct {
+	disabled atomic.Bool
+
+	mu           sync.Mutex
+	measurements []Measurement[N]
+}
+
+func (r *recorder[N]) record(ctx context.Context, value N, attrs attribute.Set) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.measurements = append(r.measurements, Measurement[N]{
+		Context:    ctx,
+		Value:      value,
+		Attributes: attrs,
+	})
+}
+
+// Measurements returns a copy of all recorded measurements in the order they
+// were made.
+func (r *recorder[N]) Measurements() []Measurement[N] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make([]Measurement[N], len(r.measurements))
+	copy(out, r.measurements)
+	return out
+}
+
+// Reset removes all recorded measurements.
+func (r *recorder[N]) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.measurements = nil
+}
+
+// Enabled reports whether the instrument is enabled. Instruments are enabled
+// unless disabled with SetEnabled.
+func (r *recorder[N]) Enabled(context.Context) bool {
+	return !r.disabled.Load()
+}
+
+// SetEnabled sets the value reported by Enabled. Measurements are recorded
+// regardless of this value.
+func (r *recorder[N]) SetEnabled(enabled bool) {
+	r.disabled.Store(!enabled)
+}
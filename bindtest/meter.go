@@ -0,0 +1,120 @@
+package bindtest
+
+import (
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// Meter is a [metric.Meter] that creates recording instruments. Instruments
+// are identified by name. Creating an instrument with the name of an existing
+// instrument of the same kind returns the existing instrument.
+//
+// Asynchronous instruments are not recorded.
+type Meter struct {
+	noop.Meter
+
+	mu          sync.Mutex
+	instruments map[string]any
+}
+
+var _ metric.Meter = (*Meter)(nil)
+
+// NewMeter returns a new [Meter].
+func NewMeter() *Meter {
+	return &Meter{instruments: make(map[string]any)}
+}
+
+// instrument returns the instrument of m named name. If no instrument exists
+// with name, it is created using newInst. An error is returned if an
+// instrument of a different kind already exists with name.
+func instrument[T any](m *Meter, name string, newInst func() T) (T, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if existing, ok := m.instruments[name]; ok {
+		inst, ok := existing.(T)
+		if !ok {
+			var zero T
+			return zero, fmt.Errorf("bindtest: instrument %q already created as %T", name, existing)
+		}
+		return inst, nil
+	}
+
+	if m.instruments == nil {
+		m.instruments = make(map[string]any)
+	}
+	inst := newInst()
+	m.instruments[name] = inst
+	return inst, nil
+}
+
+// Instrument returns the recording instrument named name created by m. If no
+// instrument exists with name, nil is returned.
+func (m *Meter) Instrument(name string) any {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.instruments[name]
+}
+
+// Int64Measurements returns the measurements recorded by the int64
+// instrument named name. If no int64 instrument exists with name, nil is
+// returned.
+func (m *Meter) Int64Measurements(name string) []Measurement[int64] {
+	if r, ok := m.Instrument(name).(Recorder[int64]); ok {
+		return r.Measurements()
+	}
+	return nil
+}
+
+// Float64Measurements returns the measurements recorded by the float64
+// instrument named name. If no float64 instrument exists with name, nil is
+// returned.
+func (m *Meter) Float64Measurements(name string) []Measurement[float64] {
+	if r, ok := m.Instrument(name).(Recorder[float64]); ok {
+		return r.Measurements()
+	}
+	return nil
+}
+
+// Int64Counter returns a recording [Int64Counter] named name.
+func (m *Meter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return instrument(m, name, func() *Int64Counter { return &Int64Counter{Name: name} })
+}
+
+// Int64UpDownCounter returns a recording [Int64UpDownCounter] named name.
+func (m *Meter) Int64UpDownCounter(name string, _ ...metric.Int64UpDownCounterOption) (metric.Int64UpDownCounter, error) {
+	return instrument(m, name, func() *Int64UpDownCounter { return &Int64UpDownCounter{Name: name} })
+}
+
+// Int64Histogram returns a recording [Int64Histogram] named name.
+func (m *Meter) Int64Histogram(name string, _ ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
+	return instrument(m, name, func() *Int64Histogram { return &Int64Histogram{Name: name} })
+}
+
+// Int64Gauge returns a recording [Int64Gauge] named name.
+func (m *Meter) Int64Gauge(name string, _ ...metric.Int64GaugeOption) (metric.Int64Gauge, error) {
+	return instrument(m, name, func() *Int64Gauge { return &Int64Gauge{Name: name} })
+}
+
+// Float64Counter returns a recording [Float64Counter] named name.
+func (m *Meter) Float64Counter(name string, _ ...metric.Float64CounterOption) (metric.Float64Counter, error) {
+	return instrument(m, name, func() *Float64Counter { return &Float64Counter{Name: name} })
+}
+
+// Float64UpDownCounter returns a recording [Float64UpDownCounter] named name.
+func (m *Meter) Float64UpDownCounter(name string, _ ...metric.Float64UpDownCounterOption) (metric.Float64UpDownCounter, error) {
+	return instrument(m, name, func() *Float64UpDownCounter { return &Float64UpDownCounter{Name: name} })
+}
+
+// Float64Histogram returns a recording [Float64Histogram] named name.
+func (m *Meter) Float64Histogram(name string, _ ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	return instrument(m, name, func() *Float64Histogram { return &Float64Histogram{Name: name} })
+}
+
+// Float64Gauge returns a recording [Float64Gauge] named name.
+func (m *Meter) Float64Gauge(name string, _ ...metric.Float64GaugeOption) (metric.Float64Gauge, error) {
+	return instrument(m, name, func() *Float64Gauge { return &Float64Gauge{Name: name} })
+}
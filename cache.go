@@ -0,0 +1,91 @@
+package bind
+
+import (
+	"container/list"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// WithCache returns an [Option] that caches up to n merged attribute sets
+// per instrument. Merged sets are keyed by the attributes determined when a
+// measurement is made (i.e. call-site, context, and baggage attributes). When
+// the cache is full, the least recently used set is evicted.
+//
+// Measurements with cached attributes are made with a single precomputed
+// attribute set, avoiding the cost of merging attributes and allocating
+// measurement options. This is most beneficial when an instrument is
+// repeatedly passed the same few attribute sets when making measurements.
+//
+// If n is less than or equal to zero, no cache is used.
+func WithCache(n int) Option {
+	return optionFunc(func(c config) config {
+		c.cacheSize = max(n, 0)
+		return c
+	})
+}
+
+// cacheEntry is a cached measurement option.
+type cacheEntry struct {
+	key attribute.Distinct
+	add []metric.AddOption
+	rec []metric.RecordOption
+}
+
+// cache is a concurrent safe LRU cache of measurement options.
+type cache struct {
+	size int
+
+	mu    sync.Mutex
+	ll    *list.List
+	items map[attribute.Distinct]*list.Element
+}
+
+func newCache(size int) *cache {
+	return &cache{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[attribute.Distinct]*list.Element, size),
+	}
+}
+
+// get returns the entry for key if it is cached.
+func (c *cache) get(key attribute.Distinct) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	return elem.Value.(*cacheEntry), true
+}
+
+// put caches o for key and returns the cache entry. If the cache is full, the
+// least recently used entry is evicted.
+func (c *cache) put(key attribute.Distinct, o metric.MeasurementOption) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		// Added concurrently.
+		c.ll.MoveToFront(elem)
+		return elem.Value.(*cacheEntry)
+	}
+
+	if c.ll.Len() >= c.size {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+
+	e := &cacheEntry{
+		key: key,
+		add: []metric.AddOption{o},
+		rec: []metric.RecordOption{o},
+	}
+	c.items[key] = c.ll.PushFront(e)
+	return e
+}
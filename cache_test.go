@@ -0,0 +1,116 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+func testCache[T any, N any](mock Mock[T, N], b Binder[T], m Measure[T, N], val N) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+		inst := bind.Configure(b(mock.Instrument(), userAlice), bind.WithCache(1))
+
+		for _, extra := range []attribute.KeyValue{userID, userID, adminTrue, userID} {
+			m(inst, ctx, val, []attribute.KeyValue{extra})
+			_, got := mock.Recorded()
+			assert.ElementsMatch(t, []attribute.KeyValue{userAlice, extra}, got)
+		}
+
+		m(inst, ctx, val, nil)
+		_, got := mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice}, got)
+	}
+}
+
+func TestWithCache(t *testing.T) {
+	t.Run("Int64Counter", testCache(&mockInt64Counter{}, bind.Int64Counter, measInt64Counter, 1))
+	t.Run("Int64UpDownCounter", testCache(&mockInt64UpDownCounter{}, bind.Int64UpDownCounter, measInt64UpDownCounter, 1))
+	t.Run("Int64Histogram", testCache(&mockInt64Histogram{}, bind.Int64Histogram, measInt64Histogram, 1))
+	t.Run("Int64Gauge", testCache(&mockInt64Gauge{}, bind.Int64Gauge, measInt64Gauge, 1))
+	t.Run("Float64Counter", testCache(&mockFloat64Counter{}, bind.Float64Counter, measFloat64Counter, 1))
+	t.Run("Float64UpDownCounter", testCache(&mockFloat64UpDownCounter{}, bind.Float64UpDownCounter, measFloat64UpDownCounter, 1))
+	t.Run("Float64Histogram", testCache(&mockFloat64Histogram{}, bind.Float64Histogram, measFloat64Histogram, 1))
+	t.Run("Float64Gauge", testCache(&mockFloat64Gauge{}, bind.Float64Gauge, measFloat64Gauge, 1))
+}
+
+func TestWithCacheRebind(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	inst := bind.Configure(bind.Float64Counter(mock, userAlice), bind.WithCache(10))
+
+	ctx := context.Background()
+	extra := metric.WithAttributes(userID)
+	inst.Add(ctx, 1, extra)
+
+	inst = bind.Float64Counter(inst, adminTrue)
+	inst.Add(ctx, 1, extra)
+
+	_, got := mock.Recorded()
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, adminTrue, userID}, got, "rebound instrument should not use parent cache")
+}
+
+func TestWithCacheLimit(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	inst := bind.Configure(
+		bind.Float64Counter(mock, userAlice),
+		bind.WithCache(10),
+		bind.WithLimit(1),
+	)
+
+	ctx := context.Background()
+	for range 3 {
+		inst.Add(ctx, 1, metric.WithAttributes(userID))
+		inst.Add(ctx, 1, metric.WithAttributes(adminTrue))
+	}
+
+	stats, _ := bind.ReadLimitStats(inst)
+	assert.Equal(t, bind.LimitStats{Sets: 1, DroppedSets: 1, DroppedMeasurements: 3}, stats, "overflow measurements should not be cached")
+}
+
+func TestWithCacheAllocs(t *testing.T) {
+	ctx := context.Background()
+	inst := bind.Configure(
+		bind.Float64Histogram(noop.Float64Histogram{}, userAlice),
+		bind.WithCache(1),
+	)
+	extra := []metric.RecordOption{metric.WithAttributes(userID)}
+
+	inst.Record(ctx, 1, extra...)
+	allocs := testing.AllocsPerRun(100, func() { inst.Record(ctx, 1, extra...) })
+	assert.Zero(t, allocs, "cached measurement should not allocate")
+}
+
+func BenchmarkWithCache(b *testing.B) {
+	ctx := context.Background()
+	base := noop.Float64Histogram{}
+	extra := []metric.RecordOption{metric.WithAttributes(adminTrue)}
+
+	b.Run("NoCache", func(b *testing.B) {
+		bound := bind.Configure(bind.Float64Histogram(base, userAlice, userID), bind.WithContextAttributes())
+
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				bound.Record(ctx, 1.0, extra...)
+			}
+		})
+	})
+
+	b.Run("Cache", func(b *testing.B) {
+		bound := bind.Configure(bind.Float64Histogram(base, userAlice, userID), bind.WithCache(16))
+
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				bound.Record(ctx, 1.0, extra...)
+			}
+		})
+	})
+}
@@ -0,0 +1,126 @@
+package bind
+
+import (
+	"reflect"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Option configures how a bound instrument determines the attributes of the
+// measurements it makes.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (f optionFunc) apply(c config) config { return f(c) }
+
+// config is the measurement configuration of a bound instrument.
+type config struct {
+	// ctxAttrs is true if attributes stored in the measurement context are
+	// included in measurements.
+	ctxAttrs bool
+	// baggage are the baggage members included in measurements.
+	baggage []baggageMember
+	// span defines the active span attributes included in measurements. If
+	// nil, no span attributes are included.
+	span *spanConfig
+	// limit is the cardinality limit of an instrument. If nil, no limit is
+	// applied.
+	limit *limitConfig
+	// filter is applied to all dynamic attributes. If nil, no attributes are
+	// filtered.
+	filter attribute.Filter
+	// filterBound is true if filter is also applied to bound attributes.
+	filterBound bool
+	// cacheSize is the maximum number of merged attribute sets cached per
+	// instrument. If zero, no cache is used.
+	cacheSize int
+	// conflict is the policy used to resolve conflicts with bound
+	// attributes.
+	conflict ConflictPolicy
+	// onConflict is the handler conflicts are reported to. If nil,
+	// conflicts are reported to otel.Handle.
+	onConflict func(Conflict)
+	// rules are the instrument rules applied by a Meter to the instruments it
+	// creates.
+	rules []instrumentRule
+}
+
+// newConfig returns a new config with opts applied to base. If base is nil, a
+// default config is used.
+func newConfig(base *config, opts []Option) *config {
+	var c config
+	if base != nil {
+		c = *base
+	}
+	for _, o := range opts {
+		c = o.apply(c)
+	}
+	return &c
+}
+
+// measured returns true if c has options applied when measurements are made.
+func (c *config) measured() bool {
+	return c.ctxAttrs ||
+		len(c.baggage) > 0 ||
+		c.span != nil ||
+		c.limit != nil ||
+		c.filter != nil ||
+		c.cacheSize > 0 ||
+		c.conflict != CallSiteWins ||
+		c.onConflict != nil
+}
+
+// Configure returns inst configured with opts. If inst is a bound instrument,
+// opts are merged with any options it is already configured with. If inst is
+// not bound, it is bound to no attributes and configured with opts.
+//
+// Configuring a [metric.Meter] or [metric.MeterProvider] configures all
+// synchronous instruments they create.
+//
+// T needs to be one of the following types, otherwise Configure panics:
+//
+//   - [metric.Int64Counter]
+//   - [metric.Int64UpDownCounter]
+//   - [metric.Int64Histogram]
+//   - [metric.Int64Gauge]
+//   - [metric.Float64Counter]
+//   - [metric.Float64UpDownCounter]
+//   - [metric.Float64Histogram]
+//   - [metric.Float64Gauge]
+//   - [metric.Meter]
+//   - [metric.MeterProvider]
+func Configure[T any](inst T, opts ...Option) T {
+	if len(opts) == 0 {
+		return inst
+	}
+
+	switch p := any(&inst).(type) {
+	case *metric.Int64Counter:
+		*p = configureInt64Counter(*p, opts)
+	case *metric.Int64UpDownCounter:
+		*p = configureInt64UpDownCounter(*p, opts)
+	case *metric.Int64Histogram:
+		*p = configureInt64Histogram(*p, opts)
+	case *metric.Int64Gauge:
+		*p = configureInt64Gauge(*p, opts)
+	case *metric.Float64Counter:
+		*p = configureFloat64Counter(*p, opts)
+	case *metric.Float64UpDownCounter:
+		*p = configureFloat64UpDownCounter(*p, opts)
+	case *metric.Float64Histogram:
+		*p = configureFloat64Histogram(*p, opts)
+	case *metric.Float64Gauge:
+		*p = configureFloat64Gauge(*p, opts)
+	case *metric.Meter:
+		*p = configureMeter(*p, opts)
+	case *metric.MeterProvider:
+		*p = configureMeterProvider(*p, opts)
+	default:
+		panic("bind: unsupported type " + reflect.TypeFor[T]().String())
+	}
+	return inst
+}
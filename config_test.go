@@ -0,0 +1,30 @@
+package bind_test
+
+import (
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func TestConfigureNoOptions(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	assert.Same(t, mock, bind.Configure[metric.Float64Counter](mock), "no options should return the same instrument")
+}
+
+func TestConfigureUnbound(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	got := bind.Configure[metric.Float64Counter](mock, bind.WithContextAttributes())
+
+	inst, set := bind.Unwrap(got)
+	assert.Same(t, mock, inst, "unwrapped instrument should be the original")
+	assert.Equal(t, 0, set.Len(), "configured instrument should not be bound")
+}
+
+func TestConfigureUnsupported(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	assert.Panics(t, func() {
+		_ = bind.Configure(mock, bind.WithContextAttributes())
+	})
+}
@@ -0,0 +1,131 @@
+package bind
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ConflictPolicy determines how a conflict between a bound attribute and
+// another attribute with the same key is resolved.
+type ConflictPolicy int
+
+const (
+	// CallSiteWins resolves conflicts using the attribute that is not bound,
+	// i.e. the attribute passed when making a measurement or the attribute
+	// being bound when rebinding. This is the default policy.
+	CallSiteWins ConflictPolicy = iota
+	// BoundWins resolves conflicts using the bound attribute.
+	BoundWins
+	// ReportConflict resolves conflicts using the bound attribute and reports
+	// the conflict. Conflicts are reported to the handler set with
+	// [WithConflictHandler], or to [otel.Handle] if no handler is set.
+	ReportConflict
+)
+
+// Conflict is a conflict between a bound attribute and another attribute with
+// the same key but a different value.
+type Conflict struct {
+	// Key is the conflicting attribute key.
+	Key attribute.Key
+	// Bound is the bound value.
+	Bound attribute.Value
+	// Override is the value that conflicts with the bound value.
+	Override attribute.Value
+}
+
+// Error returns a description of the conflict.
+func (c Conflict) Error() string {
+	return fmt.Sprintf(
+		"bind: attribute %q conflicts with bound value: %s (bound) != %s",
+		c.Key, c.Bound.Emit(), c.Override.Emit(),
+	)
+}
+
+// WithConflictPolicy returns an [Option] that resolves conflicts between
+// bound attributes and other attributes with the same key using p. This
+// applies to attributes determined when a measurement is made and to
+// attributes bound to an already bound instrument or meter.
+//
+// When [WithCache] is also used, conflicts are only resolved, and reported,
+// when the merged attributes are not already cached.
+func WithConflictPolicy(p ConflictPolicy) Option {
+	return optionFunc(func(c config) config {
+		c.conflict = p
+		return c
+	})
+}
+
+// WithConflictHandler returns an [Option] that sets the handler conflicts are
+// reported to when the [ReportConflict] policy is used.
+//
+// The function f needs to be concurrent safe.
+func WithConflictHandler(f func(Conflict)) Option {
+	return optionFunc(func(c config) config {
+		c.onConflict = f
+		return c
+	})
+}
+
+// policy returns the conflict policy of c. If c is nil, the default policy
+// is returned.
+func (c *config) policy() ConflictPolicy {
+	if c == nil {
+		return CallSiteWins
+	}
+	return c.conflict
+}
+
+// report reports conflicts between bound and override.
+func (c *config) report(bound, override attribute.Set) {
+	for iter := override.Iter(); iter.Next(); {
+		kv := iter.Attribute()
+		v, ok := bound.Value(kv.Key)
+		if !ok || v == kv.Value {
+			continue
+		}
+
+		conflict := Conflict{Key: kv.Key, Bound: v, Override: kv.Value}
+		if c.onConflict != nil {
+			c.onConflict(conflict)
+		} else {
+			otel.Handle(conflict)
+		}
+	}
+}
+
+// resolve returns the union of bound and dynamic with conflicts resolved
+// according to the policy of c.
+func (c *config) resolve(bound, dynamic attribute.Set) attribute.Set {
+	switch c.policy() {
+	case BoundWins:
+		return merge(dynamic, bound)
+	case ReportConflict:
+		c.report(bound, dynamic)
+		return merge(dynamic, bound)
+	default:
+		return merge(bound, dynamic)
+	}
+}
+
+// rebind returns the attributes of an instrument bound to prev that is bound
+// to attrs. Conflicts are resolved according to the policy of c.
+func (c *config) rebind(prev []attribute.KeyValue, prevSet attribute.Set, attrs []attribute.KeyValue) []attribute.KeyValue {
+	policy := c.policy()
+	if policy == ReportConflict {
+		// NewSet sorts passed attributes. Copy to avoid side effect.
+		tmp := make([]attribute.KeyValue, len(attrs))
+		copy(tmp, attrs)
+		c.report(prevSet, attribute.NewSet(tmp...))
+	}
+
+	// NewSet uses the last value for duplicate keys.
+	cp := make([]attribute.KeyValue, 0, len(prev)+len(attrs))
+	if policy == CallSiteWins {
+		cp = append(cp, prev...)
+		return append(cp, attrs...)
+	}
+	cp = append(cp, attrs...)
+	return append(cp, prev...)
+}
@@ -0,0 +1,113 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var userBob = attribute.String("user", "bob")
+
+func testConflictPolicy[T any, N any](mock Mock[T, N], b Binder[T], m Measure[T, N], val N) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		var conflicts []bind.Conflict
+		inst := bind.Configure(
+			b(mock.Instrument(), userAlice),
+			bind.WithConflictPolicy(bind.ReportConflict),
+			bind.WithConflictHandler(func(c bind.Conflict) { conflicts = append(conflicts, c) }),
+		)
+
+		m(inst, context.Background(), val, []attribute.KeyValue{userBob, userID})
+		_, got := mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, got, "measured attributes")
+
+		want := []bind.Conflict{{Key: "user", Bound: userAlice.Value, Override: userBob.Value}}
+		assert.Equal(t, want, conflicts, "measurement conflicts")
+
+		inst = b(inst, userBob, adminTrue)
+		_, set := bind.Unwrap(inst)
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, adminTrue}, set.ToSlice(), "rebound attributes")
+		assert.Equal(t, append(want, want...), conflicts, "rebind conflicts")
+	}
+}
+
+func TestWithConflictPolicy(t *testing.T) {
+	t.Run("Int64Counter", testConflictPolicy(&mockInt64Counter{}, bind.Int64Counter, measInt64Counter, 1))
+	t.Run("Int64UpDownCounter", testConflictPolicy(&mockInt64UpDownCounter{}, bind.Int64UpDownCounter, measInt64UpDownCounter, 1))
+	t.Run("Int64Histogram", testConflictPolicy(&mockInt64Histogram{}, bind.Int64Histogram, measInt64Histogram, 1))
+	t.Run("Int64Gauge", testConflictPolicy(&mockInt64Gauge{}, bind.Int64Gauge, measInt64Gauge, 1))
+	t.Run("Float64Counter", testConflictPolicy(&mockFloat64Counter{}, bind.Float64Counter, measFloat64Counter, 1))
+	t.Run("Float64UpDownCounter", testConflictPolicy(&mockFloat64UpDownCounter{}, bind.Float64UpDownCounter, measFloat64UpDownCounter, 1))
+	t.Run("Float64Histogram", testConflictPolicy(&mockFloat64Histogram{}, bind.Float64Histogram, measFloat64Histogram, 1))
+	t.Run("Float64Gauge", testConflictPolicy(&mockFloat64Gauge{}, bind.Float64Gauge, measFloat64Gauge, 1))
+}
+
+func TestConflictPolicies(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy bind.ConflictPolicy
+		want   attribute.KeyValue
+	}{
+		{"CallSiteWins", bind.CallSiteWins, userBob},
+		{"BoundWins", bind.BoundWins, userAlice},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := &mockFloat64Counter{}
+			inst := bind.Configure(
+				bind.Float64Counter(mock, userAlice),
+				bind.WithConflictPolicy(test.policy),
+			)
+
+			inst.Add(context.Background(), 1, metric.WithAttributes(userBob))
+			_, got := mock.Recorded()
+			assert.Equal(t, []attribute.KeyValue{test.want}, got, "measured attributes")
+
+			_, set := bind.Unwrap(bind.Float64Counter(inst, userBob))
+			assert.Equal(t, []attribute.KeyValue{test.want}, set.ToSlice(), "rebound attributes")
+		})
+	}
+}
+
+func TestReportConflictOtelHandle(t *testing.T) {
+	var errs []error
+	orig := otel.GetErrorHandler()
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { errs = append(errs, err) }))
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+
+	inst := bind.Configure(
+		bind.Float64Counter(&mockFloat64Counter{}, userAlice),
+		bind.WithConflictPolicy(bind.ReportConflict),
+	)
+
+	// Same value is not a conflict.
+	inst.Add(context.Background(), 1, metric.WithAttributes(userAlice))
+	assert.Empty(t, errs)
+
+	inst.Add(context.Background(), 1, metric.WithAttributes(userBob))
+	require.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], `bind: attribute "user" conflicts with bound value: alice (bound) != bob`)
+}
+
+func TestConflictPolicyMeter(t *testing.T) {
+	meter := bind.Configure(bind.Meter(&mockMeter{}, userAlice), bind.WithConflictPolicy(bind.BoundWins))
+	meter = bind.Meter(meter, userBob, userID)
+
+	inst, err := meter.Int64Gauge("gauge")
+	require.NoError(t, err)
+	_, set := bind.Unwrap(inst)
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, set.ToSlice(), "meter rebound attributes")
+
+	inst = bind.Int64Gauge(inst, userBob)
+	_, set = bind.Unwrap(inst)
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, set.ToSlice(), "instrument rebound attributes")
+}
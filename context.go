@@ -0,0 +1,53 @@
+package bind
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type ctxAttrsKey struct{}
+
+// ContextWithAttributes returns a copy of parent with attrs stored in it.
+// Instruments configured using [WithContextAttributes] will include these
+// attributes in all measurements made with the returned context.
+//
+// If parent already contains attributes, attrs will be merged into those
+// attributes. Attributes in attrs take precedence over those in parent with
+// the same key.
+func ContextWithAttributes(parent context.Context, attrs ...attribute.KeyValue) context.Context {
+	if len(attrs) == 0 {
+		return parent
+	}
+
+	// NewSet sorts passed attributes. Copy to avoid side effect.
+	cp := make([]attribute.KeyValue, len(attrs))
+	copy(cp, attrs)
+
+	set := merge(AttributesFromContext(parent), attribute.NewSet(cp...))
+	return context.WithValue(parent, ctxAttrsKey{}, set)
+}
+
+// AttributesFromContext returns the attributes stored in ctx by
+// [ContextWithAttributes]. An empty set is returned if ctx contains no
+// attributes.
+func AttributesFromContext(ctx context.Context) attribute.Set {
+	if set, ok := ctx.Value(ctxAttrsKey{}).(attribute.Set); ok {
+		return set
+	}
+	return *attribute.EmptySet()
+}
+
+// WithContextAttributes returns an [Option] that includes the attributes
+// stored in the measurement context by [ContextWithAttributes] in all
+// measurements.
+//
+// Context attributes take precedence over bound attributes with the same key,
+// and attributes passed when making a measurement take precedence over
+// context attributes.
+func WithContextAttributes() Option {
+	return optionFunc(func(c config) config {
+		c.ctxAttrs = true
+		return c
+	})
+}
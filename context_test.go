@@ -0,0 +1,118 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+func TestContextWithAttributes(t *testing.T) {
+	ctx := context.Background()
+	empty := bind.AttributesFromContext(ctx)
+	assert.Equal(t, 0, empty.Len(), "empty context")
+	assert.Equal(t, ctx, bind.ContextWithAttributes(ctx), "no attributes should return parent")
+
+	userBob := attribute.String("user", "bob")
+	ctx = bind.ContextWithAttributes(ctx, userAlice, userID)
+	ctx = bind.ContextWithAttributes(ctx, userBob, adminTrue)
+
+	got := bind.AttributesFromContext(ctx)
+	want := attribute.NewSet(userBob, userID, adminTrue)
+	assert.True(t, want.Equals(&got), "want %v, got %v", want.ToSlice(), got.ToSlice())
+}
+
+func TestContextWithAttributesNoSideEffects(t *testing.T) {
+	a, cpA := clone(attribute.Int("C", 3), attribute.Int("B", 2))
+	_ = bind.ContextWithAttributes(context.Background(), a...)
+	assert.Equal(t, cpA, a, "original attributes should not be modified")
+}
+
+func testContextAttributes[T any, N any](mock Mock[T, N], b Binder[T], m Measure[T, N], val N) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		userBob := attribute.String("user", "bob")
+		adminFalse := attribute.Bool("admin", false)
+
+		inst := bind.Configure(b(mock.Instrument(), userAlice, userID), bind.WithContextAttributes())
+		ctx := bind.ContextWithAttributes(context.Background(), userBob, adminTrue)
+
+		m(inst, ctx, val, []attribute.KeyValue{adminFalse})
+		_, got := mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userBob, userID, adminFalse}, got, "call-site attributes")
+
+		m(inst, ctx, val, nil)
+		_, got = mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userBob, userID, adminTrue}, got, "context attributes")
+
+		m(inst, context.Background(), val, nil)
+		_, got = mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, got, "bound attributes")
+
+		// Rebinding keeps the configuration.
+		inst = b(inst, adminFalse)
+		m(inst, ctx, val, nil)
+		_, got = mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userBob, userID, adminTrue}, got, "rebound attributes")
+	}
+}
+
+func TestWithContextAttributes(t *testing.T) {
+	t.Run("Int64Counter", testContextAttributes(&mockInt64Counter{}, bind.Int64Counter, measInt64Counter, 1))
+	t.Run("Int64UpDownCounter", testContextAttributes(&mockInt64UpDownCounter{}, bind.Int64UpDownCounter, measInt64UpDownCounter, 1))
+	t.Run("Int64Histogram", testContextAttributes(&mockInt64Histogram{}, bind.Int64Histogram, measInt64Histogram, 1))
+	t.Run("Int64Gauge", testContextAttributes(&mockInt64Gauge{}, bind.Int64Gauge, measInt64Gauge, 1))
+	t.Run("Float64Counter", testContextAttributes(&mockFloat64Counter{}, bind.Float64Counter, measFloat64Counter, 1))
+	t.Run("Float64UpDownCounter", testContextAttributes(&mockFloat64UpDownCounter{}, bind.Float64UpDownCounter, measFloat64UpDownCounter, 1))
+	t.Run("Float64Histogram", testContextAttributes(&mockFloat64Histogram{}, bind.Float64Histogram, measFloat64Histogram, 1))
+	t.Run("Float64Gauge", testContextAttributes(&mockFloat64Gauge{}, bind.Float64Gauge, measFloat64Gauge, 1))
+}
+
+func TestWithContextAttributesMeter(t *testing.T) {
+	meter := bind.Configure(bind.Meter(&mockMeter{}, userAlice), bind.WithContextAttributes())
+
+	inst, err := meter.Float64Counter("counter")
+	require.NoError(t, err)
+
+	ctx := bind.ContextWithAttributes(context.Background(), adminTrue)
+	inst.Add(ctx, 1)
+
+	mock, _ := bind.Unwrap(inst)
+	_, got := mock.(*mockFloat64Counter).Recorded()
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, adminTrue}, got)
+}
+
+func TestWithContextAttributesMeterProvider(t *testing.T) {
+	mp := bind.Configure[metric.MeterProvider](&mockMeterProvider{}, bind.WithContextAttributes())
+
+	inst, err := mp.Meter("scope").Int64Counter("counter")
+	require.NoError(t, err)
+
+	ctx := bind.ContextWithAttributes(context.Background(), adminTrue)
+	inst.Add(ctx, 1)
+
+	mock, _ := bind.Unwrap(inst)
+	_, got := mock.(*mockInt64Counter).Recorded()
+	assert.ElementsMatch(t, []attribute.KeyValue{adminTrue}, got)
+}
+
+func BenchmarkWithContextAttributes(b *testing.B) {
+	ctx := bind.ContextWithAttributes(context.Background(), adminTrue)
+	bound := bind.Configure(
+		bind.Float64Counter(noop.Float64Counter{}, userAlice, userID),
+		bind.WithContextAttributes(),
+	)
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			bound.Add(ctx, 1.0)
+		}
+	})
+}
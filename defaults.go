@@ -0,0 +1,124 @@
+package bind
+
+import (
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// InstrumentDefaults are default options applied to instruments a
+// [metric.Meter] creates. Zero values are not applied.
+type InstrumentDefaults struct {
+	// Unit is the default unit (see [metric.WithUnit]).
+	Unit string
+	// Description is the default description (see [metric.WithDescription]).
+	Description string
+	// Boundaries are the default explicit bucket boundaries of histograms
+	// (see [metric.WithExplicitBucketBoundaries]). They are not applied to
+	// other instrument kinds.
+	Boundaries []float64
+}
+
+// merge returns d with all non-zero fields of o applied.
+func (d InstrumentDefaults) merge(o InstrumentDefaults) InstrumentDefaults {
+	if o.Unit != "" {
+		d.Unit = o.Unit
+	}
+	if o.Description != "" {
+		d.Description = o.Description
+	}
+	if o.Boundaries != nil {
+		d.Boundaries = o.Boundaries
+	}
+	return d
+}
+
+// WithInstrumentDefaults returns an [Option] that applies d to all
+// instruments a [metric.Meter] creates with a name matching pattern. See
+// [WithInstrumentAttributes] for the pattern syntax.
+//
+// Defaults are only applied when the options passed to create an instrument
+// do not set the same option. When multiple patterns match, non-zero
+// defaults of later options take precedence. Defaults are also applied to
+// instruments left unbound by [WithUnboundInstruments].
+//
+// This option only applies to a Meter (see [Meter] and [Configure]). Use
+// [ReadInstrumentConfig] to inspect the defaults applied to an instrument.
+func WithInstrumentDefaults(pattern string, d InstrumentDefaults) Option {
+	g := newGlob(pattern)
+	d.Boundaries = slices.Clone(d.Boundaries)
+	return withRule(instrumentRule{glob: &g, kinds: KindAll, defaults: &d})
+}
+
+// defaults returns the defaults applied to an instrument named name of kind.
+// If c is nil, no defaults are returned.
+func (c *config) defaults(name string, kind InstrumentKind) InstrumentDefaults {
+	var d InstrumentDefaults
+	if c == nil {
+		return d
+	}
+	for _, r := range c.rules {
+		if r.defaults != nil && r.match(name, kind) {
+			d = d.merge(*r.defaults)
+		}
+	}
+	if kind&(KindInt64Histogram|KindFloat64Histogram) == 0 {
+		d.Boundaries = nil
+	}
+	return d
+}
+
+// applyDefaults returns options with d applied. Defaults are prepended so any
+// option in options takes precedence.
+func applyDefaults[O any](d InstrumentDefaults, options []O) []O {
+	var defaults []O
+	if d.Unit != "" {
+		defaults = append(defaults, any(metric.WithUnit(d.Unit)).(O))
+	}
+	if d.Description != "" {
+		defaults = append(defaults, any(metric.WithDescription(d.Description)).(O))
+	}
+	if d.Boundaries != nil {
+		if o, ok := any(metric.WithExplicitBucketBoundaries(d.Boundaries...)).(O); ok {
+			defaults = append(defaults, o)
+		}
+	}
+	if len(defaults) == 0 {
+		return options
+	}
+	return append(defaults, options...)
+}
+
+// InstrumentConfig is the effective configuration a bound [metric.Meter]
+// applies to an instrument it creates.
+type InstrumentConfig struct {
+	// Bound is false if the instrument is left unbound (see
+	// [WithUnboundInstruments]).
+	Bound bool
+	// Attributes are the attributes bound to the instrument.
+	Attributes attribute.Set
+	// Defaults are the default options applied to the instrument.
+	Defaults InstrumentDefaults
+}
+
+// ReadInstrumentConfig returns the configuration m applies to an instrument
+// named name of kind. If m is not a Meter returned by [Meter] or [Configure],
+// false is returned.
+func ReadInstrumentConfig(m metric.Meter, name string, kind InstrumentKind) (InstrumentConfig, bool) {
+	bm, ok := m.(*meter)
+	if !ok {
+		return InstrumentConfig{}, false
+	}
+
+	c := InstrumentConfig{
+		Attributes: *attribute.EmptySet(),
+		Defaults:   bm.cfg.defaults(name, kind),
+	}
+	// Do not expose the configured boundaries to modification.
+	c.Defaults.Boundaries = slices.Clone(c.Defaults.Boundaries)
+	if b, bound := bm.binding(name, kind); bound {
+		c.Bound, c.Attributes = true, b.set
+	}
+	return c, true
+}
@@ -0,0 +1,157 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// optsMeter records the configuration of created instruments.
+type optsMeter struct {
+	noop.Meter
+
+	hist  metric.Float64HistogramConfig
+	cntr  metric.Int64CounterConfig
+	gauge metric.Float64ObservableGaugeConfig
+}
+
+func (m *optsMeter) Float64Histogram(n string, o ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	m.hist = metric.NewFloat64HistogramConfig(o...)
+	return m.Meter.Float64Histogram(n, o...)
+}
+
+func (m *optsMeter) Int64Counter(n string, o ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	m.cntr = metric.NewInt64CounterConfig(o...)
+	return m.Meter.Int64Counter(n, o...)
+}
+
+func (m *optsMeter) Float64ObservableGauge(n string, o ...metric.Float64ObservableGaugeOption) (metric.Float64ObservableGauge, error) {
+	m.gauge = metric.NewFloat64ObservableGaugeConfig(o...)
+	return m.Meter.Float64ObservableGauge(n, o...)
+}
+
+var latencyBounds = []float64{0.005, 0.01, 0.05, 0.1, 0.5, 1}
+
+func defaultsMeter(mock metric.Meter) metric.Meter {
+	return bind.Configure(
+		bind.Meter(mock, userAlice),
+		bind.WithInstrumentDefaults("*.duration", bind.InstrumentDefaults{
+			Unit:       "s",
+			Boundaries: latencyBounds,
+		}),
+		bind.WithInstrumentDefaults("http.*", bind.InstrumentDefaults{
+			Description: "HTTP instrument",
+		}),
+	)
+}
+
+func TestWithInstrumentDefaults(t *testing.T) {
+	mock := &optsMeter{}
+	meter := defaultsMeter(mock)
+
+	_, err := meter.Float64Histogram("http.duration")
+	require.NoError(t, err)
+	assert.Equal(t, "s", mock.hist.Unit())
+	assert.Equal(t, "HTTP instrument", mock.hist.Description())
+	assert.Equal(t, latencyBounds, mock.hist.ExplicitBucketBoundaries())
+
+	_, err = meter.Int64Counter("http.requests")
+	require.NoError(t, err)
+	assert.Empty(t, mock.cntr.Unit())
+	assert.Equal(t, "HTTP instrument", mock.cntr.Description())
+
+	_, err = meter.Float64ObservableGauge("db.duration")
+	require.NoError(t, err)
+	assert.Equal(t, "s", mock.gauge.Unit(), "defaults should apply to asynchronous instruments")
+}
+
+func TestWithInstrumentDefaultsCallerOptions(t *testing.T) {
+	mock := &optsMeter{}
+	meter := defaultsMeter(mock)
+
+	_, err := meter.Float64Histogram(
+		"http.duration",
+		metric.WithUnit("ms"),
+		metric.WithExplicitBucketBoundaries(1, 10),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "ms", mock.hist.Unit(), "caller unit")
+	assert.Equal(t, []float64{1, 10}, mock.hist.ExplicitBucketBoundaries(), "caller boundaries")
+	assert.Equal(t, "HTTP instrument", mock.hist.Description(), "default description")
+}
+
+func TestWithInstrumentDefaultsUnbound(t *testing.T) {
+	mock := &optsMeter{}
+	meter := bind.Configure(defaultsMeter(mock), bind.WithUnboundInstruments("http.requests"))
+
+	_, err := meter.Int64Counter("http.requests")
+	require.NoError(t, err)
+	assert.Equal(t, "HTTP instrument", mock.cntr.Description())
+}
+
+func TestReadInstrumentConfig(t *testing.T) {
+	meter := bind.Configure(
+		defaultsMeter(&optsMeter{}),
+		bind.WithInstrumentAttributes("http.*", adminTrue),
+		bind.WithUnboundInstruments("runtime.*"),
+	)
+
+	got, ok := bind.ReadInstrumentConfig(meter, "http.duration", bind.KindFloat64Histogram)
+	require.True(t, ok)
+	assert.True(t, got.Bound)
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, adminTrue}, got.Attributes.ToSlice())
+	want := bind.InstrumentDefaults{Unit: "s", Description: "HTTP instrument", Boundaries: latencyBounds}
+	assert.Equal(t, want, got.Defaults)
+
+	got, ok = bind.ReadInstrumentConfig(meter, "http.duration", bind.KindFloat64Counter)
+	require.True(t, ok)
+	assert.Nil(t, got.Defaults.Boundaries, "boundaries only apply to histograms")
+
+	got, ok = bind.ReadInstrumentConfig(meter, "runtime.gc", bind.KindInt64Counter)
+	require.True(t, ok)
+	assert.False(t, got.Bound)
+	assert.Equal(t, 0, got.Attributes.Len())
+
+	_, ok = bind.ReadInstrumentConfig(noop.Meter{}, "http.duration", bind.KindFloat64Histogram)
+	assert.False(t, ok, "unbound meter")
+}
+
+func TestWithInstrumentDefaultsNoSideEffects(t *testing.T) {
+	bounds := []float64{1, 2}
+	meter := bind.Configure[metric.Meter](
+		&optsMeter{},
+		bind.WithInstrumentDefaults("*", bind.InstrumentDefaults{Boundaries: bounds}),
+	)
+	bounds[0] = 100
+
+	got, _ := bind.ReadInstrumentConfig(meter, "hist", bind.KindInt64Histogram)
+	assert.Equal(t, []float64{1, 2}, got.Defaults.Boundaries)
+}
+
+func TestWithInstrumentDefaultsAllocs(t *testing.T) {
+	inst, err := defaultsMeter(noop.Meter{}).Int64Counter("http.requests")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	allocs := testing.AllocsPerRun(100, func() { inst.Add(ctx, 1) })
+	assert.Zero(t, allocs, "instrument defaults should not allocate per measurement")
+}
+
+func BenchmarkWithInstrumentDefaults(b *testing.B) {
+	ctx := context.Background()
+	inst, err := defaultsMeter(noop.Meter{}).Int64Counter("http.requests")
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			inst.Add(ctx, 1)
+		}
+	})
+}
@@ -31,7 +31,105 @@ The package supports all OpenTelemetry synchronous metric instrument types:
   - Float64Histogram
   - Float64Gauge.
 
-Bound instruments can be further bound with additional attributes, or the
-original instrument and attributes can be retrieved using [Unwrap].
+Generic code can use [Bind] to bind attributes to any of these instrument
+types.
+
+Use [Instruments] to bind attributes to all instrument fields of a struct.
+
+Use [Meter] to bind attributes to all instruments created by a
+[go.opentelemetry.io/otel/metric.Meter]. This includes asynchronous
+instruments: observations made in callbacks for instruments created by a bound
+Meter, whether passed when the instrument is created or registered with
+RegisterCallback, include the bound attributes.
+
+A Meter can be configured to bind attributes per instrument by name pattern
+using [WithInstrumentAttributes], by kind using [WithKindAttributes], or to
+leave instruments unbound using [WithUnboundInstruments].
+
+Team conventions for instruments can be applied by a Meter using
+[WithInstrumentDefaults], which sets a default unit, description, and
+histogram bucket boundaries by name pattern. Use [ReadInstrumentConfig] to
+inspect the configuration a Meter applies to an instrument.
+
+Instrumentation libraries that accept a
+[go.opentelemetry.io/otel/metric.MeterProvider] can be bound using
+[MeterProvider]. Attributes can be bound only to Meters with a matching
+instrumentation scope name using [ScopedMeterProvider].
+
+Bound instruments can be configured to determine additional attributes when a
+measurement is made using [Configure]. For example, [WithContextAttributes]
+includes attributes stored in the measurement context with
+[ContextWithAttributes]:
+
+	counter = bind.Configure(counter, bind.WithContextAttributes())
+
+	// Measured with {"user": "Alice", "tenant": "acme"}
+	ctx = bind.ContextWithAttributes(ctx, attribute.String("tenant", "acme"))
+	counter.Add(ctx, 1.0)
+
+Similarly, [WithBaggage] includes an allowlist of W3C baggage members from the
+measurement context, and [WithSpanAttributes] includes an allowlist of
+attributes of the active span when the span implementation exposes them.
+
+Attributes are merged in order of precedence: attributes passed when making
+a measurement override context attributes, which override span attributes,
+which override baggage attributes, which override bound attributes. Use [WithConflictPolicy] to instead
+guarantee bound attributes cannot be overridden.
+
+Attributes determined when a measurement is made can be restricted using
+[WithFilter], [WithAllowKeys], or [WithDenyKeys]. Configuring a Meter with
+these options sandboxes all instruments it creates.
+
+Instruments repeatedly measured with the same few dynamic attribute sets can
+use [WithCache] to cache merged attribute sets, avoiding allocations for
+repeated measurements.
+
+The number of distinct attribute sets an instrument measures can be limited
+using [Limit] or [WithLimit]. Measurements for new attribute sets beyond the
+limit are recorded with the bound attributes and an overflow attribute.
+
+Attribute sets can be defined once as Go types using "otel" struct tags.
+[StructAttributes] converts a tagged struct to attributes and [Struct] binds
+them:
+
+	type Route struct {
+		Method string `otel:"http.request.method"`
+		Path   string `otel:"http.route"`
+	}
+
+	counter = bind.Struct(counter, Route{Method: "GET", Path: "/users"})
+
+Code migrating from Prometheus client libraries can use Vec families, such as
+[Float64CounterVec], to declare attribute keys once and retrieve cached bound
+instruments by attribute values:
+
+	requests := bind.NewFloat64CounterVec(counter, []string{"method", "route"})
+
+	// Measured with {"method": "GET", "route": "/users"}
+	requests.WithLabelValues("GET", "/users").Add(ctx, 1.0)
+
+Use [WithMaxEntries] or [WithIdleTimeout] to bound the number of cached
+instruments.
+
+Attributes that change at runtime can be held in [SwappableAttributes] and
+bound using [Swappable]. Replacing the attributes with
+[SwappableAttributes.Set] or [SwappableAttributes.Update] applies to all
+instruments bound to them:
+
+	role := bind.NewSwappableAttributes(attribute.String("role", "follower"))
+	counter = bind.Swappable(counter, role)
+
+	// Measured with {"user": "Alice", "role": "leader"}
+	role.Set(attribute.String("role", "leader"))
+	counter.Add(ctx, 1.0)
+
+Attributes can be bound to spans as well. All spans started by a
+[go.opentelemetry.io/otel/trace.Tracer] bound using [Tracer], or by the
+tracers of a provider bound using [TracerProvider], include the bound
+attributes.
+
+Bound instruments can be further bound with additional attributes, bound
+attributes can be removed using [Without], or the original instrument and
+attributes can be retrieved using [Unwrap].
 */
 package bind
@@ -0,0 +1,74 @@
+package bind
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// WithFilter returns an [Option] that removes all attributes rejected by f
+// from the attributes determined when a measurement is made. This includes
+// attributes passed when making the measurement and attributes from the
+// measurement context. Bound attributes are not filtered unless
+// [WithFilterBoundAttributes] is also used.
+//
+// Multiple filter options can be used. An attribute needs to be accepted by
+// all filters to be included in a measurement.
+func WithFilter(f attribute.Filter) Option {
+	return optionFunc(func(c config) config {
+		if f == nil {
+			return c
+		}
+		if prev := c.filter; prev != nil {
+			c.filter = func(kv attribute.KeyValue) bool {
+				return prev(kv) && f(kv)
+			}
+		} else {
+			c.filter = f
+		}
+		return c
+	})
+}
+
+// WithAllowKeys returns an [Option] that only includes attributes with keys
+// in the keys allowlist. See [WithFilter] for which attributes are filtered.
+func WithAllowKeys(keys ...attribute.Key) Option {
+	return WithFilter(attribute.NewAllowKeysFilter(keys...))
+}
+
+// WithDenyKeys returns an [Option] that excludes attributes with keys in the
+// keys denylist. See [WithFilter] for which attributes are filtered.
+func WithDenyKeys(keys ...attribute.Key) Option {
+	return WithFilter(attribute.NewDenyKeysFilter(keys...))
+}
+
+// WithFilterBoundAttributes returns an [Option] that also applies the
+// configured filters (see [WithFilter]) to bound attributes when they are
+// bound. Bound attributes that are rejected are removed from the bound
+// attributes and an error is reported using [otel.Handle].
+func WithFilterBoundAttributes() Option {
+	return optionFunc(func(c config) config {
+		c.filterBound = true
+		return c
+	})
+}
+
+// bound returns the bound attributes set after applying the configured
+// filters if c is configured to filter bound attributes. If c is nil, set is
+// returned unchanged.
+func (c *config) bound(set attribute.Set) attribute.Set {
+	if c == nil || !c.filterBound || c.filter == nil {
+		return set
+	}
+
+	set, rejected := set.Filter(c.filter)
+	if len(rejected) > 0 {
+		keys := make([]attribute.Key, len(rejected))
+		for i, kv := range rejected {
+			keys[i] = kv.Key
+		}
+		otel.Handle(fmt.Errorf("bind: bound attributes rejected by filter: %v", keys))
+	}
+	return set
+}
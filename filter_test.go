@@ -0,0 +1,98 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var userEmail = attribute.String("user.email", "alice@example.com")
+
+func testFilter[T any, N any](mock Mock[T, N], b Binder[T], m Measure[T, N], val N) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		inst := bind.Configure(
+			b(mock.Instrument(), userAlice),
+			bind.WithDenyKeys("user.email"),
+			bind.WithContextAttributes(),
+		)
+		ctx := bind.ContextWithAttributes(context.Background(), userEmail)
+
+		m(inst, ctx, val, []attribute.KeyValue{userID, userEmail})
+		_, got := mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, got)
+	}
+}
+
+func TestWithFilter(t *testing.T) {
+	t.Run("Int64Counter", testFilter(&mockInt64Counter{}, bind.Int64Counter, measInt64Counter, 1))
+	t.Run("Int64UpDownCounter", testFilter(&mockInt64UpDownCounter{}, bind.Int64UpDownCounter, measInt64UpDownCounter, 1))
+	t.Run("Int64Histogram", testFilter(&mockInt64Histogram{}, bind.Int64Histogram, measInt64Histogram, 1))
+	t.Run("Int64Gauge", testFilter(&mockInt64Gauge{}, bind.Int64Gauge, measInt64Gauge, 1))
+	t.Run("Float64Counter", testFilter(&mockFloat64Counter{}, bind.Float64Counter, measFloat64Counter, 1))
+	t.Run("Float64UpDownCounter", testFilter(&mockFloat64UpDownCounter{}, bind.Float64UpDownCounter, measFloat64UpDownCounter, 1))
+	t.Run("Float64Histogram", testFilter(&mockFloat64Histogram{}, bind.Float64Histogram, measFloat64Histogram, 1))
+	t.Run("Float64Gauge", testFilter(&mockFloat64Gauge{}, bind.Float64Gauge, measFloat64Gauge, 1))
+}
+
+func TestWithAllowKeys(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	inst := bind.Configure(
+		bind.Float64Counter(mock, userAlice),
+		bind.WithAllowKeys("id", "admin"),
+		bind.WithFilter(func(kv attribute.KeyValue) bool { return kv.Key != "admin" }),
+	)
+
+	inst.Add(context.Background(), 1, metric.WithAttributes(userID, adminTrue, userEmail))
+
+	_, got := mock.Recorded()
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, got, "bound attributes should not be filtered")
+}
+
+func TestWithFilterBoundAttributes(t *testing.T) {
+	var errs []error
+	orig := otel.GetErrorHandler()
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { errs = append(errs, err) }))
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+
+	inst := bind.Configure(
+		bind.Float64Counter(&mockFloat64Counter{}, userAlice, userEmail),
+		bind.WithDenyKeys("user.email"),
+		bind.WithFilterBoundAttributes(),
+	)
+	_, set := bind.Unwrap(inst)
+	assert.Equal(t, []attribute.KeyValue{userAlice}, set.ToSlice(), "configured instrument")
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], "user.email")
+
+	inst = bind.Float64Counter(inst, userEmail, userID)
+	_, set = bind.Unwrap(inst)
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, set.ToSlice(), "rebound instrument")
+	assert.Len(t, errs, 2)
+}
+
+func TestWithFilterMeter(t *testing.T) {
+	meter := bind.Configure(
+		bind.Meter(&mockMeter{}, userAlice, userEmail),
+		bind.WithDenyKeys("user.email"),
+		bind.WithFilterBoundAttributes(),
+	)
+	meter = bind.Meter(meter, userID)
+
+	inst, err := meter.Int64Histogram("histogram")
+	require.NoError(t, err)
+	_, set := bind.Unwrap(inst)
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, set.ToSlice(), "bound attributes")
+
+	inst.Record(context.Background(), 1, metric.WithAttributes(userEmail, adminTrue))
+	mock, _ := bind.Unwrap(inst)
+	_, got := mock.(*mockInt64Histogram).Recorded()
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID, adminTrue}, got, "measured attributes")
+}
@@ -18,27 +18,30 @@ func Float64Counter(inst metric.Float64Counter, attrs ...attribute.KeyValue) met
 		return inst
 	}
 
-	// NewSet sorts passed attributes. Copy to avoid side effect.
-	var cp []attribute.KeyValue
+	var (
+		// NewSet sorts passed attributes. Copy to avoid side effect.
+		cp []attribute.KeyValue
+		p  *pipeline
+	)
 
 	if i, ok := inst.(float64Counter); ok {
 		// Flatten the instrument if already bound.
 		inst = i.inst
+		p = i.p.fork()
 
-		cp = make([]attribute.KeyValue, 0, len(i.attrs)+len(attrs))
-		cp = append(cp, i.attrs...)
-		cp = append(cp, attrs...)
+		cp = p.config().rebind(i.attrs, i.set, attrs)
 	} else {
 		cp = make([]attribute.KeyValue, len(attrs))
 		copy(cp, attrs)
 	}
 
-	set := attribute.NewSet(cp...)
+	set := p.config().bound(attribute.NewSet(cp...))
 	return float64Counter{
 		inst:  inst,
 		attrs: cp,
 		set:   set,
 		o:     []metric.AddOption{metric.WithAttributeSet(set)},
+		p:     p,
 	}
 }
 
@@ -49,6 +52,7 @@ type float64Counter struct {
 	attrs []attribute.KeyValue
 	set   attribute.Set
 	o     []metric.AddOption
+	p     *pipeline
 }
 
 // Unwrap returns the underlying [metric.Float64Counter] and the bound
@@ -57,6 +61,10 @@ func (i float64Counter) Unwrap() (metric.Float64Counter, attribute.Set) {
 	return i.inst, i.set
 }
 
+func (i float64Counter) pipeline() *pipeline {
+	return i.p
+}
+
 // Enabled reports whether the underlying instrument will process measurements.
 func (i float64Counter) Enabled(ctx context.Context) bool {
 	return i.inst.Enabled(ctx)
@@ -65,6 +73,12 @@ func (i float64Counter) Enabled(ctx context.Context) bool {
 // Add records a change to the counter. All measurements made will
 // include the attributes bound to the instrument.
 func (i float64Counter) Add(ctx context.Context, incr float64, opts ...metric.AddOption) {
+	if i.p != nil {
+		set := metric.NewAddConfig(opts).Attributes()
+		i.inst.Add(ctx, incr, i.p.addOptions(ctx, i.set, set)...)
+		return
+	}
+
 	if len(opts) == 0 {
 		i.inst.Add(ctx, incr, i.o...)
 		return
@@ -80,3 +94,37 @@ func (i float64Counter) Add(ctx context.Context, incr float64, opts ...metric.Ad
 	*o = append(*o, opts...)
 	i.inst.Add(ctx, incr, *o...)
 }
+
+// configureFloat64Counter returns inst configured with opts.
+func configureFloat64Counter(inst metric.Float64Counter, opts []Option) metric.Float64Counter {
+	i, ok := inst.(float64Counter)
+	if !ok {
+		i = float64Counter{inst: inst, set: *attribute.EmptySet()}
+	}
+	i.p = newPipeline(newConfig(i.p.config(), opts))
+	i.set = i.p.config().bound(i.set)
+	return i
+}
+
+// withoutFloat64Counter returns inst without the bound attributes with keys.
+func withoutFloat64Counter(inst metric.Float64Counter, keys []attribute.Key) metric.Float64Counter {
+	i, ok := inst.(float64Counter)
+	if !ok {
+		return inst
+	}
+
+	attrs := without(i.attrs, keys)
+	if len(attrs) == 0 && i.p == nil {
+		return i.inst
+	}
+
+	p := i.p.fork()
+	set := p.config().bound(attribute.NewSet(attrs...))
+	return float64Counter{
+		inst:  i.inst,
+		attrs: attrs,
+		set:   set,
+		o:     []metric.AddOption{metric.WithAttributeSet(set)},
+		p:     p,
+	}
+}
@@ -18,27 +18,30 @@ func Float64Histogram(inst metric.Float64Histogram, attrs ...attribute.KeyValue)
 		return inst
 	}
 
-	// NewSet sorts passed attributes. Copy to avoid side effect.
-	var cp []attribute.KeyValue
+	var (
+		// NewSet sorts passed attributes. Copy to avoid side effect.
+		cp []attribute.KeyValue
+		p  *pipeline
+	)
 
 	if i, ok := inst.(float64Histogram); ok {
 		// Flatten the instrument if already bound.
 		inst = i.inst
+		p = i.p.fork()
 
-		cp = make([]attribute.KeyValue, 0, len(i.attrs)+len(attrs))
-		cp = append(cp, i.attrs...)
-		cp = append(cp, attrs...)
+		cp = p.config().rebind(i.attrs, i.set, attrs)
 	} else {
 		cp = make([]attribute.KeyValue, len(attrs))
 		copy(cp, attrs)
 	}
 
-	set := attribute.NewSet(cp...)
+	set := p.config().bound(attribute.NewSet(cp...))
 	return float64Histogram{
 		inst:  inst,
 		attrs: cp,
 		set:   set,
 		o:     []metric.RecordOption{metric.WithAttributeSet(set)},
+		p:     p,
 	}
 }
 
@@ -49,6 +52,7 @@ type float64Histogram struct {
 	attrs []attribute.KeyValue
 	set   attribute.Set
 	o     []metric.RecordOption
+	p     *pipeline
 }
 
 // Unwrap returns the underlying [metric.Float64Histogram] and the bound
@@ -57,6 +61,10 @@ func (i float64Histogram) Unwrap() (metric.Float64Histogram, attribute.Set) {
 	return i.inst, i.set
 }
 
+func (i float64Histogram) pipeline() *pipeline {
+	return i.p
+}
+
 // Enabled reports whether the underlying instrument will process measurements.
 func (i float64Histogram) Enabled(ctx context.Context) bool {
 	return i.inst.Enabled(ctx)
@@ -65,6 +73,12 @@ func (i float64Histogram) Enabled(ctx context.Context) bool {
 // Record adds a value to the histogram. All measurements made will
 // include the attributes bound to the instrument.
 func (i float64Histogram) Record(ctx context.Context, value float64, opts ...metric.RecordOption) {
+	if i.p != nil {
+		set := metric.NewRecordConfig(opts).Attributes()
+		i.inst.Record(ctx, value, i.p.recordOptions(ctx, i.set, set)...)
+		return
+	}
+
 	if len(opts) == 0 {
 		i.inst.Record(ctx, value, i.o...)
 		return
@@ -80,3 +94,37 @@ func (i float64Histogram) Record(ctx context.Context, value float64, opts ...met
 	*o = append(*o, opts...)
 	i.inst.Record(ctx, value, *o...)
 }
+
+// configureFloat64Histogram returns inst configured with opts.
+func configureFloat64Histogram(inst metric.Float64Histogram, opts []Option) metric.Float64Histogram {
+	i, ok := inst.(float64Histogram)
+	if !ok {
+		i = float64Histogram{inst: inst, set: *attribute.EmptySet()}
+	}
+	i.p = newPipeline(newConfig(i.p.config(), opts))
+	i.set = i.p.config().bound(i.set)
+	return i
+}
+
+// withoutFloat64Histogram returns inst without the bound attributes with keys.
+func withoutFloat64Histogram(inst metric.Float64Histogram, keys []attribute.Key) metric.Float64Histogram {
+	i, ok := inst.(float64Histogram)
+	if !ok {
+		return inst
+	}
+
+	attrs := without(i.attrs, keys)
+	if len(attrs) == 0 && i.p == nil {
+		return i.inst
+	}
+
+	p := i.p.fork()
+	set := p.config().bound(attribute.NewSet(attrs...))
+	return float64Histogram{
+		inst:  i.inst,
+		attrs: attrs,
+		set:   set,
+		o:     []metric.RecordOption{metric.WithAttributeSet(set)},
+		p:     p,
+	}
+}
@@ -0,0 +1,64 @@
+package bind
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// float64ObservableCounter is a bound [metric.Float64ObservableCounter]. It can
+// only be observed through a bound Meter (see [Meter]).
+type float64ObservableCounter struct {
+	metric.Float64ObservableCounter
+
+	set attribute.Set
+	o   []metric.ObserveOption
+}
+
+// Unwrap returns the underlying [metric.Float64ObservableCounter] and the bound
+// attribute set.
+func (i float64ObservableCounter) Unwrap() (metric.Float64ObservableCounter, attribute.Set) {
+	return i.Float64ObservableCounter, i.set
+}
+
+func (i float64ObservableCounter) base() (metric.Float64Observable, []metric.ObserveOption) {
+	return i.Float64ObservableCounter, i.o
+}
+
+// float64ObservableUpDownCounter is a bound
+// [metric.Float64ObservableUpDownCounter]. It can only be observed through a
+// bound Meter (see [Meter]).
+type float64ObservableUpDownCounter struct {
+	metric.Float64ObservableUpDownCounter
+
+	set attribute.Set
+	o   []metric.ObserveOption
+}
+
+// Unwrap returns the underlying [metric.Float64ObservableUpDownCounter] and the
+// bound attribute set.
+func (i float64ObservableUpDownCounter) Unwrap() (metric.Float64ObservableUpDownCounter, attribute.Set) {
+	return i.Float64ObservableUpDownCounter, i.set
+}
+
+func (i float64ObservableUpDownCounter) base() (metric.Float64Observable, []metric.ObserveOption) {
+	return i.Float64ObservableUpDownCounter, i.o
+}
+
+// float64ObservableGauge is a bound [metric.Float64ObservableGauge]. It can
+// only be observed through a bound Meter (see [Meter]).
+type float64ObservableGauge struct {
+	metric.Float64ObservableGauge
+
+	set attribute.Set
+	o   []metric.ObserveOption
+}
+
+// Unwrap returns the underlying [metric.Float64ObservableGauge] and the bound
+// attribute set.
+func (i float64ObservableGauge) Unwrap() (metric.Float64ObservableGauge, attribute.Set) {
+	return i.Float64ObservableGauge, i.set
+}
+
+func (i float64ObservableGauge) base() (metric.Float64Observable, []metric.ObserveOption) {
+	return i.Float64ObservableGauge, i.o
+}
@@ -0,0 +1,39 @@
+package bind
+
+import "strings"
+
+// glob is a compiled name pattern. The wildcard '*' matches any sequence of
+// characters, including an empty one. All other characters match themselves.
+type glob struct {
+	// parts are the literal parts of the pattern split on '*'.
+	parts []string
+}
+
+func newGlob(pattern string) glob {
+	return glob{parts: strings.Split(pattern, "*")}
+}
+
+// match reports whether name matches g.
+func (g glob) match(name string) bool {
+	if len(g.parts) == 1 {
+		// No wildcard, exact match.
+		return name == g.parts[0]
+	}
+
+	first, last := g.parts[0], g.parts[len(g.parts)-1]
+	if len(name) < len(first)+len(last) ||
+		!strings.HasPrefix(name, first) ||
+		!strings.HasSuffix(name, last) {
+		return false
+	}
+
+	name = name[len(first) : len(name)-len(last)]
+	for _, part := range g.parts[1 : len(g.parts)-1] {
+		i := strings.Index(name, part)
+		if i < 0 {
+			return false
+		}
+		name = name[i+len(part):]
+	}
+	return true
+}
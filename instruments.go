@@ -0,0 +1,167 @@
+package bind
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// fieldBinders are the functions used to bind instrument fields by field
+// type.
+var fieldBinders = map[reflect.Type]func(reflect.Value, []attribute.KeyValue){
+	reflect.TypeFor[metric.Int64Counter]():         fieldBinder(Int64Counter),
+	reflect.TypeFor[metric.Int64UpDownCounter]():   fieldBinder(Int64UpDownCounter),
+	reflect.TypeFor[metric.Int64Histogram]():       fieldBinder(Int64Histogram),
+	reflect.TypeFor[metric.Int64Gauge]():           fieldBinder(Int64Gauge),
+	reflect.TypeFor[metric.Float64Counter]():       fieldBinder(Float64Counter),
+	reflect.TypeFor[metric.Float64UpDownCounter](): fieldBinder(Float64UpDownCounter),
+	reflect.TypeFor[metric.Float64Histogram]():     fieldBinder(Float64Histogram),
+	reflect.TypeFor[metric.Float64Gauge]():         fieldBinder(Float64Gauge),
+}
+
+// fieldBinder returns a function that sets a field of type T to the value
+// returned by bind for the field's instrument.
+func fieldBinder[T any](bind func(T, ...attribute.KeyValue) T) func(reflect.Value, []attribute.KeyValue) {
+	return func(v reflect.Value, attrs []attribute.KeyValue) {
+		v.Set(reflect.ValueOf(bind(v.Interface().(T), attrs...)))
+	}
+}
+
+// Instruments returns a copy of the struct s with attrs bound to all of its
+// exported instrument fields. It is used to bind attributes to a group of
+// instruments at once:
+//
+//	type Instruments struct {
+//		Requests metric.Int64Counter
+//		Latency  metric.Float64Histogram
+//		InFlight metric.Int64UpDownCounter
+//	}
+//
+//	tenantInsts := bind.Instruments(insts, attribute.String("tenant", "acme"))
+//
+// Instrument fields are fields with one of the synchronous instrument
+// interface types (e.g. [metric.Float64Counter]) as their type. Nil fields
+// are left unchanged. Fields of nested structs, including embedded structs,
+// are bound as well. Nested structs with instrument fields referenced by a
+// pointer are copied so s is never modified. A struct referenced more than
+// once is copied once, and the copy is referenced instead. All other fields,
+// including pointers to structs without instrument fields, are copied
+// unchanged.
+//
+// Instruments panics if S is not a struct type.
+func Instruments[S any](s S, attrs ...attribute.KeyValue) S {
+	v := reflect.ValueOf(&s).Elem()
+	if v.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("bind: Instruments of non-struct type %s", v.Type()))
+	}
+	if len(attrs) > 0 && hasInstruments(v.Type()) {
+		bindFields(v, attrs, make(map[pointer]reflect.Value))
+	}
+	return s
+}
+
+// instrumentTypes caches whether a struct type has instrument fields.
+var instrumentTypes sync.Map // map[reflect.Type]bool
+
+// hasInstruments reports whether the struct type t has settable instrument
+// fields, including fields of nested structs.
+func hasInstruments(t reflect.Type) bool {
+	if has, ok := instrumentTypes.Load(t); ok {
+		return has.(bool)
+	}
+	has := structHasInstruments(t, make(map[reflect.Type]bool))
+	instrumentTypes.Store(t, has)
+	return has
+}
+
+// structHasInstruments reports whether the struct type t has settable
+// instrument fields. Types in visiting are already being checked and are not
+// checked again so recursive types terminate.
+func structHasInstruments(t reflect.Type, visiting map[reflect.Type]bool) bool {
+	if visiting[t] {
+		return false
+	}
+	visiting[t] = true
+
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		ft := sf.Type
+		if !sf.IsExported() {
+			if sf.Anonymous && ft.Kind() == reflect.Struct && structHasInstruments(ft, visiting) {
+				return true
+			}
+			continue
+		}
+
+		if _, ok := fieldBinders[ft]; ok {
+			return true
+		}
+
+		switch {
+		case ft.Kind() == reflect.Struct:
+			if structHasInstruments(ft, visiting) {
+				return true
+			}
+		case ft.Kind() == reflect.Pointer && ft.Elem().Kind() == reflect.Struct:
+			if structHasInstruments(ft.Elem(), visiting) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pointer identifies a pointer by its type and address. The type is needed
+// because a struct and its first field share the same address.
+type pointer struct {
+	typ  reflect.Type
+	addr uintptr
+}
+
+// bindFields binds attrs to all settable instrument fields of the struct v.
+// Copies of the structs referenced by pointers are stored in copies by the
+// original pointer so a struct referenced more than once, including by
+// itself, is only copied once.
+func bindFields(v reflect.Value, attrs []attribute.KeyValue, copies map[pointer]reflect.Value) {
+	for i := range v.NumField() {
+		f := v.Field(i)
+		if !f.CanSet() {
+			if v.Type().Field(i).Anonymous && f.Kind() == reflect.Struct && hasInstruments(f.Type()) {
+				// Exported fields of unexported embedded structs are settable.
+				bindFields(f, attrs, copies)
+			}
+			continue
+		}
+
+		if bind, ok := fieldBinders[f.Type()]; ok {
+			if !f.IsNil() {
+				bind(f, attrs)
+			}
+			continue
+		}
+
+		switch {
+		case f.Kind() == reflect.Struct:
+			if hasInstruments(f.Type()) {
+				bindFields(f, attrs, copies)
+			}
+		case f.Kind() == reflect.Pointer && f.Type().Elem().Kind() == reflect.Struct && !f.IsNil():
+			if !hasInstruments(f.Type().Elem()) {
+				continue
+			}
+			if cp, ok := copies[pointer{f.Type(), f.Pointer()}]; ok {
+				f.Set(cp)
+				continue
+			}
+			// Copy the referenced struct so the original is not modified.
+			cp := reflect.New(f.Type().Elem())
+			copies[pointer{f.Type(), f.Pointer()}] = cp
+			cp.Elem().Set(f.Elem())
+			bindFields(cp.Elem(), attrs, copies)
+			f.Set(cp)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type dbInstruments struct {
+	Queries metric.Int64Counter
+}
+
+type baseInstruments struct {
+	Errors metric.Float64Counter
+}
+
+type serverConfig struct {
+	Addr string
+}
+
+type serverInstruments struct {
+	baseInstruments
+
+	Requests metric.Int64Counter
+	Latency  metric.Float64Histogram
+	InFlight metric.Int64UpDownCounter
+	Unset    metric.Int64Gauge
+	DB       *dbInstruments
+	Config   *serverConfig
+	Name     string
+
+	internal metric.Int64Counter
+}
+
+func TestInstruments(t *testing.T) {
+	var (
+		requests = &mockInt64Counter{}
+		latency  = &mockFloat64Histogram{}
+		inFlight = &mockInt64UpDownCounter{}
+		errs     = &mockFloat64Counter{}
+		queries  = &mockInt64Counter{}
+		internal = &mockInt64Counter{}
+	)
+	orig := serverInstruments{
+		baseInstruments: baseInstruments{Errors: errs},
+		Requests:        requests,
+		Latency:         latency,
+		InFlight:        inFlight,
+		DB:              &dbInstruments{Queries: queries},
+		Config:          &serverConfig{Addr: ":8080"},
+		Name:            "server",
+		internal:        internal,
+	}
+
+	got := bind.Instruments(orig, userAlice)
+
+	ctx := context.Background()
+	got.Requests.Add(ctx, 1)
+	got.Latency.Record(ctx, 1)
+	got.InFlight.Add(ctx, 1)
+	got.Errors.Add(ctx, 1)
+	got.DB.Queries.Add(ctx, 1)
+
+	want := []attribute.KeyValue{userAlice}
+	for _, m := range []interface {
+		Recorded() (*int64, []attribute.KeyValue)
+	}{requests, inFlight, queries} {
+		_, attrs := m.Recorded()
+		assert.Equal(t, want, attrs)
+	}
+	for _, m := range []interface {
+		Recorded() (*float64, []attribute.KeyValue)
+	}{latency, errs} {
+		_, attrs := m.Recorded()
+		assert.Equal(t, want, attrs)
+	}
+
+	assert.Nil(t, got.Unset, "nil fields should be unchanged")
+	assert.Equal(t, "server", got.Name)
+	assert.Same(t, orig.Config, got.Config, "pointers to structs without instruments should be unchanged")
+	assert.Same(t, internal, got.internal, "unexported fields should be unchanged")
+
+	assert.Same(t, requests, orig.Requests, "original should not be modified")
+	assert.Same(t, errs, orig.Errors, "original embedded struct should not be modified")
+	assert.Same(t, queries, orig.DB.Queries, "original nested struct should not be modified")
+}
+
+type node struct {
+	Counter metric.Int64Counter
+	Next    *node
+}
+
+func TestInstrumentsCycle(t *testing.T) {
+	mock := &mockInt64Counter{}
+	orig := &node{Counter: mock}
+	orig.Next = orig
+
+	got := bind.Instruments(*orig, userAlice)
+	assert.Same(t, got.Next, got.Next.Next, "cycle should be preserved in the copy")
+	assert.Same(t, orig, orig.Next, "original should not be modified")
+	assert.Same(t, mock, orig.Counter, "original should not be modified")
+
+	got.Next.Counter.Add(context.Background(), 1)
+	_, attrs := mock.Recorded()
+	assert.Equal(t, []attribute.KeyValue{userAlice}, attrs)
+}
+
+func TestInstrumentsNoAttributes(t *testing.T) {
+	requests := &mockInt64Counter{}
+	got := bind.Instruments(serverInstruments{Requests: requests})
+	assert.Same(t, requests, got.Requests)
+}
+
+func TestInstrumentsNonStruct(t *testing.T) {
+	assert.Panics(t, func() { bind.Instruments(&serverInstruments{}, userAlice) })
+}
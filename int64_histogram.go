@@ -18,27 +18,30 @@ func Int64Histogram(inst metric.Int64Histogram, attrs ...attribute.KeyValue) met
 		return inst
 	}
 
-	// NewSet sorts passed attributes. Copy to avoid side effect.
-	var cp []attribute.KeyValue
+	var (
+		// NewSet sorts passed attributes. Copy to avoid side effect.
+		cp []attribute.KeyValue
+		p  *pipeline
+	)
 
 	if i, ok := inst.(int64Histogram); ok {
 		// Flatten the instrument if already bound.
 		inst = i.inst
+		p = i.p.fork()
 
-		cp = make([]attribute.KeyValue, 0, len(i.attrs)+len(attrs))
-		cp = append(cp, i.attrs...)
-		cp = append(cp, attrs...)
+		cp = p.config().rebind(i.attrs, i.set, attrs)
 	} else {
 		cp = make([]attribute.KeyValue, len(attrs))
 		copy(cp, attrs)
 	}
 
-	set := attribute.NewSet(cp...)
+	set := p.config().bound(attribute.NewSet(cp...))
 	return int64Histogram{
 		inst:  inst,
 		attrs: cp,
 		set:   set,
 		o:     []metric.RecordOption{metric.WithAttributeSet(set)},
+		p:     p,
 	}
 }
 
@@ -49,6 +52,7 @@ type int64Histogram struct {
 	attrs []attribute.KeyValue
 	set   attribute.Set
 	o     []metric.RecordOption
+	p     *pipeline
 }
 
 // Unwrap returns the underlying [metric.Int64Histogram] and the bound
@@ -57,6 +61,10 @@ func (i int64Histogram) Unwrap() (metric.Int64Histogram, attribute.Set) {
 	return i.inst, i.set
 }
 
+func (i int64Histogram) pipeline() *pipeline {
+	return i.p
+}
+
 // Enabled reports whether the underlying instrument will process measurements.
 func (i int64Histogram) Enabled(ctx context.Context) bool {
 	return i.inst.Enabled(ctx)
@@ -65,6 +73,12 @@ func (i int64Histogram) Enabled(ctx context.Context) bool {
 // Record adds a value to the histogram. All measurements made will
 // include the attributes bound to the instrument.
 func (i int64Histogram) Record(ctx context.Context, value int64, opts ...metric.RecordOption) {
+	if i.p != nil {
+		set := metric.NewRecordConfig(opts).Attributes()
+		i.inst.Record(ctx, value, i.p.recordOptions(ctx, i.set, set)...)
+		return
+	}
+
 	if len(opts) == 0 {
 		i.inst.Record(ctx, value, i.o...)
 		return
@@ -80,3 +94,37 @@ func (i int64Histogram) Record(ctx context.Context, value int64, opts ...metric.
 	*o = append(*o, opts...)
 	i.inst.Record(ctx, value, *o...)
 }
+
+// configureInt64Histogram returns inst configured with opts.
+func configureInt64Histogram(inst metric.Int64Histogram, opts []Option) metric.Int64Histogram {
+	i, ok := inst.(int64Histogram)
+	if !ok {
+		i = int64Histogram{inst: inst, set: *attribute.EmptySet()}
+	}
+	i.p = newPipeline(newConfig(i.p.config(), opts))
+	i.set = i.p.config().bound(i.set)
+	return i
+}
+
+// withoutInt64Histogram returns inst without the bound attributes with keys.
+func withoutInt64Histogram(inst metric.Int64Histogram, keys []attribute.Key) metric.Int64Histogram {
+	i, ok := inst.(int64Histogram)
+	if !ok {
+		return inst
+	}
+
+	attrs := without(i.attrs, keys)
+	if len(attrs) == 0 && i.p == nil {
+		return i.inst
+	}
+
+	p := i.p.fork()
+	set := p.config().bound(attribute.NewSet(attrs...))
+	return int64Histogram{
+		inst:  i.inst,
+		attrs: attrs,
+		set:   set,
+		o:     []metric.RecordOption{metric.WithAttributeSet(set)},
+		p:     p,
+	}
+}
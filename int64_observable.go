@@ -0,0 +1,64 @@
+package bind
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// int64ObservableCounter is a bound [metric.Int64ObservableCounter]. It can
+// only be observed through a bound Meter (see [Meter]).
+type int64ObservableCounter struct {
+	metric.Int64ObservableCounter
+
+	set attribute.Set
+	o   []metric.ObserveOption
+}
+
+// Unwrap returns the underlying [metric.Int64ObservableCounter] and the bound
+// attribute set.
+func (i int64ObservableCounter) Unwrap() (metric.Int64ObservableCounter, attribute.Set) {
+	return i.Int64ObservableCounter, i.set
+}
+
+func (i int64ObservableCounter) base() (metric.Int64Observable, []metric.ObserveOption) {
+	return i.Int64ObservableCounter, i.o
+}
+
+// int64ObservableUpDownCounter is a bound
+// [metric.Int64ObservableUpDownCounter]. It can only be observed through a
+// bound Meter (see [Meter]).
+type int64ObservableUpDownCounter struct {
+	metric.Int64ObservableUpDownCounter
+
+	set attribute.Set
+	o   []metric.ObserveOption
+}
+
+// Unwrap returns the underlying [metric.Int64ObservableUpDownCounter] and the
+// bound attribute set.
+func (i int64ObservableUpDownCounter) Unwrap() (metric.Int64ObservableUpDownCounter, attribute.Set) {
+	return i.Int64ObservableUpDownCounter, i.set
+}
+
+func (i int64ObservableUpDownCounter) base() (metric.Int64Observable, []metric.ObserveOption) {
+	return i.Int64ObservableUpDownCounter, i.o
+}
+
+// int64ObservableGauge is a bound [metric.Int64ObservableGauge]. It can only be
+// observed through a bound Meter (see [Meter]).
+type int64ObservableGauge struct {
+	metric.Int64ObservableGauge
+
+	set attribute.Set
+	o   []metric.ObserveOption
+}
+
+// Unwrap returns the underlying [metric.Int64ObservableGauge] and the bound
+// attribute set.
+func (i int64ObservableGauge) Unwrap() (metric.Int64ObservableGauge, attribute.Set) {
+	return i.Int64ObservableGauge, i.set
+}
+
+func (i int64ObservableGauge) base() (metric.Int64Observable, []metric.ObserveOption) {
+	return i.Int64ObservableGauge, i.o
+}
@@ -18,27 +18,30 @@ func Int64UpDownCounter(inst metric.Int64UpDownCounter, attrs ...attribute.KeyVa
 		return inst
 	}
 
-	// NewSet sorts passed attributes. Copy to avoid side effect.
-	var cp []attribute.KeyValue
+	var (
+		// NewSet sorts passed attributes. Copy to avoid side effect.
+		cp []attribute.KeyValue
+		p  *pipeline
+	)
 
 	if i, ok := inst.(int64UpDownCounter); ok {
 		// Flatten the instrument if already bound.
 		inst = i.inst
+		p = i.p.fork()
 
-		cp = make([]attribute.KeyValue, 0, len(i.attrs)+len(attrs))
-		cp = append(cp, i.attrs...)
-		cp = append(cp, attrs...)
+		cp = p.config().rebind(i.attrs, i.set, attrs)
 	} else {
 		cp = make([]attribute.KeyValue, len(attrs))
 		copy(cp, attrs)
 	}
 
-	set := attribute.NewSet(cp...)
+	set := p.config().bound(attribute.NewSet(cp...))
 	return int64UpDownCounter{
 		inst:  inst,
 		attrs: cp,
 		set:   set,
 		o:     []metric.AddOption{metric.WithAttributeSet(set)},
+		p:     p,
 	}
 }
 
@@ -49,6 +52,7 @@ type int64UpDownCounter struct {
 	attrs []attribute.KeyValue
 	set   attribute.Set
 	o     []metric.AddOption
+	p     *pipeline
 }
 
 // Unwrap returns the underlying [metric.Int64UpDownCounter] and the bound
@@ -57,6 +61,10 @@ func (i int64UpDownCounter) Unwrap() (metric.Int64UpDownCounter, attribute.Set)
 	return i.inst, i.set
 }
 
+func (i int64UpDownCounter) pipeline() *pipeline {
+	return i.p
+}
+
 // Enabled reports whether the underlying instrument will process measurements.
 func (i int64UpDownCounter) Enabled(ctx context.Context) bool {
 	return i.inst.Enabled(ctx)
@@ -65,6 +73,12 @@ func (i int64UpDownCounter) Enabled(ctx context.Context) bool {
 // Add increments or decrements the counter by incr. All measurements made will
 // include the attributes bound to the instrument.
 func (i int64UpDownCounter) Add(ctx context.Context, incr int64, opts ...metric.AddOption) {
+	if i.p != nil {
+		set := metric.NewAddConfig(opts).Attributes()
+		i.inst.Add(ctx, incr, i.p.addOptions(ctx, i.set, set)...)
+		return
+	}
+
 	if len(opts) == 0 {
 		i.inst.Add(ctx, incr, i.o...)
 		return
@@ -80,3 +94,37 @@ func (i int64UpDownCounter) Add(ctx context.Context, incr int64, opts ...metric.
 	*o = append(*o, opts...)
 	i.inst.Add(ctx, incr, *o...)
 }
+
+// configureInt64UpDownCounter returns inst configured with opts.
+func configureInt64UpDownCounter(inst metric.Int64UpDownCounter, opts []Option) metric.Int64UpDownCounter {
+	i, ok := inst.(int64UpDownCounter)
+	if !ok {
+		i = int64UpDownCounter{inst: inst, set: *attribute.EmptySet()}
+	}
+	i.p = newPipeline(newConfig(i.p.config(), opts))
+	i.set = i.p.config().bound(i.set)
+	return i
+}
+
+// withoutInt64UpDownCounter returns inst without the bound attributes with keys.
+func withoutInt64UpDownCounter(inst metric.Int64UpDownCounter, keys []attribute.Key) metric.Int64UpDownCounter {
+	i, ok := inst.(int64UpDownCounter)
+	if !ok {
+		return inst
+	}
+
+	attrs := without(i.attrs, keys)
+	if len(attrs) == 0 && i.p == nil {
+		return i.inst
+	}
+
+	p := i.p.fork()
+	set := p.config().bound(attribute.NewSet(attrs...))
+	return int64UpDownCounter{
+		inst:  i.inst,
+		attrs: attrs,
+		set:   set,
+		o:     []metric.AddOption{metric.WithAttributeSet(set)},
+		p:     p,
+	}
+}
@@ -0,0 +1,70 @@
+// Package instconfig provides the configuration and instrument creation
+// shared by the instrumentation packages of this module (e.g. bindhttp).
+package instconfig
+
+import (
+	"slices"
+
+	"github.com/MrAlias/bind"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Config is the configuration common to all instrumentation packages. It is
+// embedded in the configuration of each package.
+type Config struct {
+	MeterProvider metric.MeterProvider
+	Attributes    []attribute.KeyValue
+}
+
+func (c *Config) config() *Config { return c }
+
+// Meter returns the Meter of the configured MeterProvider, or the global
+// MeterProvider if none is configured, bound to the configured attributes.
+func (c *Config) Meter(name string, opts ...metric.MeterOption) metric.Meter {
+	mp := c.MeterProvider
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+	return bind.Meter(mp.Meter(name, opts...), c.Attributes...)
+}
+
+// Option configures the configuration C of an instrumentation package.
+type Option[C any] interface {
+	Apply(*C)
+}
+
+// OptionFunc is an [Option] implemented by a function.
+type OptionFunc[C any] func(*C)
+
+// Apply calls f with c.
+func (f OptionFunc[C]) Apply(c *C) { f(c) }
+
+// embedsConfig is implemented by pointers to configurations embedding
+// Config.
+type embedsConfig[C any] interface {
+	*C
+	config() *Config
+}
+
+// New returns c with opts applied.
+func New[C any, O Option[C]](c C, opts []O) C {
+	for _, o := range opts {
+		o.Apply(&c)
+	}
+	return c
+}
+
+// WithMeterProvider returns an [Option] that sets the MeterProvider of C.
+func WithMeterProvider[C any, P embedsConfig[C]](mp metric.MeterProvider) OptionFunc[C] {
+	return func(c *C) { P(c).config().MeterProvider = mp }
+}
+
+// WithAttributes returns an [Option] that adds attrs to the attributes of C.
+func WithAttributes[C any, P embedsConfig[C]](attrs []attribute.KeyValue) OptionFunc[C] {
+	return func(c *C) {
+		cfg := P(c).config()
+		cfg.Attributes = slices.Concat(cfg.Attributes, attrs)
+	}
+}
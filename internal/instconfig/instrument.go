@@ -0,0 +1,49 @@
+package instconfig
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// Float64Histogram returns the histogram created by m. An error creating it
+// is passed to [otel.Handle], and if m returned no histogram, a no-op
+// histogram is returned so instrumentation keeps working.
+func Float64Histogram(m metric.Meter, name string, opts ...metric.Float64HistogramOption) metric.Float64Histogram {
+	h, err := m.Float64Histogram(name, opts...)
+	if err != nil {
+		otel.Handle(err)
+	}
+	if h == nil {
+		return noop.Float64Histogram{}
+	}
+	return h
+}
+
+// Int64Histogram returns the histogram created by m. An error creating it is
+// passed to [otel.Handle], and if m returned no histogram, a no-op histogram
+// is returned so instrumentation keeps working.
+func Int64Histogram(m metric.Meter, name string, opts ...metric.Int64HistogramOption) metric.Int64Histogram {
+	h, err := m.Int64Histogram(name, opts...)
+	if err != nil {
+		otel.Handle(err)
+	}
+	if h == nil {
+		return noop.Int64Histogram{}
+	}
+	return h
+}
+
+// Int64UpDownCounter returns the counter created by m. An error creating it
+// is passed to [otel.Handle], and if m returned no counter, a no-op counter
+// is returned so instrumentation keeps working.
+func Int64UpDownCounter(m metric.Meter, name string, opts ...metric.Int64UpDownCounterOption) metric.Int64UpDownCounter {
+	c, err := m.Int64UpDownCounter(name, opts...)
+	if err != nil {
+		otel.Handle(err)
+	}
+	if c == nil {
+		return noop.Int64UpDownCounter{}
+	}
+	return c
+}
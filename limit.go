@@ -0,0 +1,223 @@
+package bind
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// overflowAttr is the default attribute measurements exceeding a cardinality
+// limit are recorded with.
+var overflowAttr = attribute.Bool("otel.metric.overflow", true)
+
+// LimitOption configures the cardinality limit of an instrument.
+type LimitOption interface {
+	applyLimit(limitConfig) limitConfig
+}
+
+type limitOptionFunc func(limitConfig) limitConfig
+
+func (f limitOptionFunc) applyLimit(c limitConfig) limitConfig { return f(c) }
+
+type limitConfig struct {
+	max      int
+	overflow attribute.Set
+	onLimit  func(attribute.Set)
+}
+
+// WithOverflowAttributes returns a [LimitOption] that sets the attributes
+// measurements exceeding the limit are recorded with. These attributes are
+// merged with the bound attributes of the instrument. By default,
+// {"otel.metric.overflow": true} is used.
+func WithOverflowAttributes(attrs ...attribute.KeyValue) LimitOption {
+	// NewSet sorts passed attributes. Copy to avoid side effect.
+	cp := make([]attribute.KeyValue, len(attrs))
+	copy(cp, attrs)
+	set := attribute.NewSet(cp...)
+
+	return limitOptionFunc(func(c limitConfig) limitConfig {
+		c.overflow = set
+		return c
+	})
+}
+
+// WithOnLimit returns a [LimitOption] that calls f with the attributes of a
+// measurement that is redirected to the overflow attributes because the
+// limit was reached. It is called the first time each distinct attribute set
+// is dropped. Once as many distinct sets as the limit have been dropped, it is
+// called for every measurement of a set not dropped before.
+//
+// The function f needs to be concurrent safe.
+func WithOnLimit(f func(dropped attribute.Set)) LimitOption {
+	return limitOptionFunc(func(c limitConfig) limitConfig {
+		c.onLimit = f
+		return c
+	})
+}
+
+// WithLimit returns an [Option] that limits the number of distinct attribute
+// sets an instrument measures to n. Once n distinct attribute sets have been
+// measured, measurements for any new attribute set are recorded with the
+// bound attributes merged with the overflow attributes instead (see
+// [WithOverflowAttributes]).
+//
+// The limit is tracked per instrument. When used to configure a
+// [metric.Meter], each instrument it creates has its own limit. Instruments
+// derived from a limited instrument by binding additional attributes,
+// including the instruments of a [Vec], share its limit.
+//
+// If n is less than or equal to zero, no limit is applied.
+func WithLimit(n int, opts ...LimitOption) Option {
+	lc := limitConfig{max: n, overflow: attribute.NewSet(overflowAttr)}
+	for _, o := range opts {
+		lc = o.applyLimit(lc)
+	}
+
+	return optionFunc(func(c config) config {
+		if n <= 0 {
+			c.limit = nil
+		} else {
+			c.limit = &lc
+		}
+		return c
+	})
+}
+
+// Limit returns inst configured to limit the number of distinct attribute
+// sets it measures to n. It is equivalent to:
+//
+//	Configure(inst, WithLimit(n, opts...))
+//
+// See [Configure] for the supported types of T.
+func Limit[T any](inst T, n int, opts ...LimitOption) T {
+	return Configure(inst, WithLimit(n, opts...))
+}
+
+// LimitStats are the cardinality limit statistics of an instrument.
+type LimitStats struct {
+	// Sets is the number of distinct attribute sets measured, not including
+	// the overflow attribute set.
+	Sets int
+	// DroppedSets is the number of distinct attribute sets that were
+	// recorded with the overflow attributes because the limit was reached.
+	// At most as many dropped sets as the limit are tracked, so DroppedSets
+	// never exceeds the limit.
+	DroppedSets int
+	// DroppedMeasurements is the number of measurements that were recorded
+	// with the overflow attributes because the limit was reached.
+	DroppedMeasurements uint64
+}
+
+// ReadLimitStats returns the cardinality limit statistics of inst. If inst is
+// not an instrument configured with [WithLimit], false is returned.
+func ReadLimitStats(inst any) (LimitStats, bool) {
+	c, ok := inst.(configured)
+	if !ok {
+		return LimitStats{}, false
+	}
+	p := c.pipeline()
+	if p == nil || p.limiter == nil {
+		return LimitStats{}, false
+	}
+	return p.limiter.stats(), true
+}
+
+// limiter tracks the distinct attribute sets measured by an instrument. It is
+// shared by all instruments derived from the instrument by binding additional
+// attributes, as they measure the same underlying instrument.
+type limiter struct {
+	cfg *limitConfig
+
+	mu   sync.RWMutex
+	seen map[attribute.Distinct]struct{}
+	// dropped are the distinct dropped attribute sets. At most cfg.max sets
+	// are tracked.
+	dropped map[attribute.Distinct]struct{}
+	// overflow are the overflow sets merged with each bound set. At most
+	// cfg.max sets are cached.
+	overflow map[attribute.Distinct]attribute.Set
+
+	measurements atomic.Uint64
+}
+
+func newLimiter(cfg *limitConfig) *limiter {
+	return &limiter{
+		cfg:      cfg,
+		seen:     make(map[attribute.Distinct]struct{}),
+		dropped:  make(map[attribute.Distinct]struct{}),
+		overflow: make(map[attribute.Distinct]attribute.Set),
+	}
+}
+
+// limit returns set and true if it has been seen before or if the limit has
+// not been reached. Otherwise, the overflow set merged with bound and false
+// are returned.
+func (l *limiter) limit(bound, set attribute.Set) (attribute.Set, bool) {
+	key, boundKey := set.Equivalent(), bound.Equivalent()
+
+	l.mu.RLock()
+	_, ok := l.seen[key]
+	_, known := l.dropped[key]
+	overflow, cached := l.overflow[boundKey]
+	l.mu.RUnlock()
+	if ok {
+		return set, true
+	}
+
+	var first bool
+	if !known || !cached {
+		// Only take the write lock for sets not yet known to be dropped.
+		l.mu.Lock()
+		ok, first, overflow = l.admit(key, boundKey, bound)
+		l.mu.Unlock()
+		if ok {
+			return set, true
+		}
+	}
+
+	l.measurements.Add(1)
+	if first && l.cfg.onLimit != nil {
+		l.cfg.onLimit(set)
+	}
+	return overflow, false
+}
+
+// admit adds the set with key to the seen sets if the limit has not been
+// reached and returns true. Otherwise, false, whether the set was dropped
+// for the first time, and the overflow set merged with bound are returned.
+//
+// The write lock of l needs to be held.
+func (l *limiter) admit(key, boundKey attribute.Distinct, bound attribute.Set) (bool, bool, attribute.Set) {
+	if _, ok := l.seen[key]; ok {
+		return true, false, attribute.Set{}
+	}
+	if len(l.seen) < l.cfg.max {
+		l.seen[key] = struct{}{}
+		return true, false, attribute.Set{}
+	}
+
+	_, known := l.dropped[key]
+	if !known && len(l.dropped) < l.cfg.max {
+		l.dropped[key] = struct{}{}
+	}
+
+	overflow, ok := l.overflow[boundKey]
+	if !ok {
+		overflow = merge(bound, l.cfg.overflow)
+		if len(l.overflow) < l.cfg.max {
+			l.overflow[boundKey] = overflow
+		}
+	}
+	return false, !known, overflow
+}
+
+func (l *limiter) stats() LimitStats {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return LimitStats{
+		Sets:                len(l.seen),
+		DroppedSets:         len(l.dropped),
+		DroppedMeasurements: l.measurements.Load(),
+	}
+}
@@ -0,0 +1,146 @@
+package bind_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+var overflow = attribute.Bool("otel.metric.overflow", true)
+
+func testLimit[T any, N any](mock Mock[T, N], b Binder[T], m Measure[T, N], val N) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+		inst := bind.Limit(b(mock.Instrument(), userAlice), 2)
+
+		for i := range 2 {
+			m(inst, ctx, val, []attribute.KeyValue{attribute.Int("n", i)})
+			_, got := mock.Recorded()
+			assert.ElementsMatch(t, []attribute.KeyValue{userAlice, attribute.Int("n", i)}, got, "under limit")
+		}
+
+		m(inst, ctx, val, []attribute.KeyValue{attribute.Int("n", 2)})
+		_, got := mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, overflow}, got, "over limit")
+
+		m(inst, ctx, val, []attribute.KeyValue{attribute.Int("n", 0)})
+		_, got = mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, attribute.Int("n", 0)}, got, "seen set")
+
+		stats, ok := bind.ReadLimitStats(inst)
+		require.True(t, ok, "limit stats")
+		assert.Equal(t, bind.LimitStats{Sets: 2, DroppedSets: 1, DroppedMeasurements: 1}, stats)
+	}
+}
+
+func TestLimit(t *testing.T) {
+	t.Run("Int64Counter", testLimit(&mockInt64Counter{}, bind.Int64Counter, measInt64Counter, 1))
+	t.Run("Int64UpDownCounter", testLimit(&mockInt64UpDownCounter{}, bind.Int64UpDownCounter, measInt64UpDownCounter, 1))
+	t.Run("Int64Histogram", testLimit(&mockInt64Histogram{}, bind.Int64Histogram, measInt64Histogram, 1))
+	t.Run("Int64Gauge", testLimit(&mockInt64Gauge{}, bind.Int64Gauge, measInt64Gauge, 1))
+	t.Run("Float64Counter", testLimit(&mockFloat64Counter{}, bind.Float64Counter, measFloat64Counter, 1))
+	t.Run("Float64UpDownCounter", testLimit(&mockFloat64UpDownCounter{}, bind.Float64UpDownCounter, measFloat64UpDownCounter, 1))
+	t.Run("Float64Histogram", testLimit(&mockFloat64Histogram{}, bind.Float64Histogram, measFloat64Histogram, 1))
+	t.Run("Float64Gauge", testLimit(&mockFloat64Gauge{}, bind.Float64Gauge, measFloat64Gauge, 1))
+}
+
+func TestLimitOptions(t *testing.T) {
+	var dropped []attribute.Set
+	mock := &mockInt64Counter{}
+	inst := bind.Limit[metric.Int64Counter](
+		mock, 1,
+		bind.WithOverflowAttributes(attribute.String("series", "other")),
+		bind.WithOnLimit(func(s attribute.Set) { dropped = append(dropped, s) }),
+	)
+
+	ctx := context.Background()
+	inst.Add(ctx, 1, metric.WithAttributes(userAlice))
+	inst.Add(ctx, 1, metric.WithAttributes(userID))
+	inst.Add(ctx, 1, metric.WithAttributes(userID))
+
+	_, got := mock.Recorded()
+	assert.Equal(t, []attribute.KeyValue{attribute.String("series", "other")}, got)
+	assert.Equal(t, []attribute.Set{attribute.NewSet(userID)}, dropped, "called once per dropped set")
+}
+
+func TestLimitSharedByBoundInstruments(t *testing.T) {
+	mock := &mockInt64Counter{}
+	inst := bind.Limit(bind.Int64Counter(mock, userAlice), 2)
+
+	ctx := context.Background()
+	inst.Add(ctx, 1, metric.WithAttributes(userID))
+
+	bob := attribute.String("user", "bob")
+	child := bind.Int64Counter(inst, bob)
+	child.Add(ctx, 1, metric.WithAttributes(userID))
+	_, got := mock.Recorded()
+	assert.ElementsMatch(t, []attribute.KeyValue{bob, userID}, got, "under shared limit")
+
+	child.Add(ctx, 1, metric.WithAttributes(adminTrue))
+	_, got = mock.Recorded()
+	assert.ElementsMatch(t, []attribute.KeyValue{bob, overflow}, got, "overflow of child bound set")
+
+	inst.Add(ctx, 1, metric.WithAttributes(adminTrue))
+	_, got = mock.Recorded()
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, overflow}, got, "overflow of parent bound set")
+
+	stats, ok := bind.ReadLimitStats(child)
+	require.True(t, ok)
+	assert.Equal(t, bind.LimitStats{Sets: 2, DroppedSets: 2, DroppedMeasurements: 2}, stats)
+}
+
+func TestLimitNonPositive(t *testing.T) {
+	mock := &mockInt64Counter{}
+	inst := bind.Limit(bind.Int64Counter(mock, userAlice), 0)
+
+	_, ok := bind.ReadLimitStats(inst)
+	assert.False(t, ok, "non-positive limit should not be applied")
+}
+
+func TestReadLimitStatsNotLimited(t *testing.T) {
+	_, ok := bind.ReadLimitStats(bind.Int64Counter(&mockInt64Counter{}, userAlice))
+	assert.False(t, ok, "bound instrument")
+
+	_, ok = bind.ReadLimitStats(&mockInt64Counter{})
+	assert.False(t, ok, "unbound instrument")
+}
+
+func TestLimitMeter(t *testing.T) {
+	meter := bind.Limit(bind.Meter(&mockMeter{}, userAlice), 1)
+
+	ctx := context.Background()
+	for _, name := range []string{"a", "b"} {
+		inst, err := meter.Int64Counter(name)
+		require.NoError(t, err)
+
+		inst.Add(ctx, 1, metric.WithAttributes(userID))
+		mock, _ := bind.Unwrap(inst)
+		_, got := mock.(*mockInt64Counter).Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, got, "limit should be per instrument")
+	}
+}
+
+func TestLimitConcurrentSafe(t *testing.T) {
+	inst := bind.Limit(bind.Float64Counter(noop.Float64Counter{}, userAlice), 10)
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Go(func() {
+			inst.Add(context.Background(), 1, metric.WithAttributes(attribute.Int("n", i)))
+		})
+	}
+	wg.Wait()
+
+	stats, ok := bind.ReadLimitStats(inst)
+	require.True(t, ok)
+	assert.Equal(t, bind.LimitStats{Sets: 10, DroppedSets: 10, DroppedMeasurements: 10}, stats)
+}
@@ -1,40 +1,116 @@
 package bind
 
 import (
+	"context"
+
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 )
 
-// Meter binds attrs to m. All synchronous instruments created with the
-// returned [metric.Meter] will bind attrs to the equivalent synchronous
-// instrument created by m.
+// Meter binds attrs to m. All instruments created with the returned
+// [metric.Meter] will bind attrs to the equivalent instrument created by m.
+//
+// Observations made for asynchronous instruments created by the returned
+// [metric.Meter] will include attrs. This applies to callbacks passed when
+// creating the instrument and to callbacks registered with the RegisterCallback
+// method of the returned [metric.Meter].
+//
+// Asynchronous instruments created by the returned [metric.Meter] are bound
+// wrappers the SDK does not recognize. They can only be registered with the
+// RegisterCallback method of a bound [metric.Meter], and observed by the
+// [metric.Observer] it passes to callbacks. Use [Unwrap] to get the underlying
+// instrument and its attributes to register it with m or another unbound Meter
+// directly. Observations of the underlying instrument need to include the
+// attributes explicitly.
+//
+// Use [Configure] with [WithInstrumentAttributes], [WithKindAttributes], or
+// [WithUnboundInstruments] to bind attributes per instrument.
 func Meter(m metric.Meter, attrs ...attribute.KeyValue) metric.Meter {
 	if len(attrs) == 0 {
 		return m
 	}
 
-	// NewSet sorts passed attributes. Copy to avoid side effect.
-	var cp []attribute.KeyValue
+	var (
+		// NewSet sorts passed attributes. Copy to avoid side effect.
+		cp  []attribute.KeyValue
+		cfg *config
+	)
 
 	if i, ok := m.(*meter); ok {
 		// Flatten the meter if already bound.
 		m = i.Meter
-		cp = make([]attribute.KeyValue, 0, len(i.attrs)+len(attrs))
-		cp = append(cp, i.attrs...)
-		cp = append(cp, attrs...)
+		cfg = i.cfg
+		cp = cfg.rebind(i.attrs, i.set, attrs)
 	} else {
 		cp = make([]attribute.KeyValue, len(attrs))
 		copy(cp, attrs)
 	}
 
-	set := attribute.NewSet(cp...)
+	return newMeter(m, cp, cfg.bound(attribute.NewSet(cp...)), cfg)
+}
+
+// configureMeter returns m configured with opts.
+func configureMeter(m metric.Meter, opts []Option) metric.Meter {
+	i, ok := m.(*meter)
+	if !ok {
+		cfg := newConfig(nil, opts)
+		return newMeter(m, nil, *attribute.EmptySet(), cfg)
+	}
+
+	cfg := newConfig(i.cfg, opts)
+	return newMeter(i.Meter, i.attrs, cfg.bound(i.set), cfg)
+}
+
+// withoutMeter returns m without the bound attributes with keys.
+func withoutMeter(m metric.Meter, keys []attribute.Key) metric.Meter {
+	i, ok := m.(*meter)
+	if !ok {
+		return m
+	}
+
+	attrs := without(i.attrs, keys)
+	if len(attrs) == 0 && i.cfg == nil {
+		return i.Meter
+	}
+	return newMeter(i.Meter, attrs, i.cfg.bound(attribute.NewSet(attrs...)), i.cfg)
+}
+
+// binding returns the bound Meter that binds an instrument named name of
+// kind according to the instrument rules of m. It returns false if the
+// instrument is not bound.
+func (m *meter) binding(name string, kind InstrumentKind) (*meter, bool) {
+	if m.cfg == nil || len(m.cfg.rules) == 0 {
+		return m, true
+	}
+
+	var attrs []attribute.KeyValue
+	for _, r := range m.cfg.rules {
+		if !r.match(name, kind) {
+			continue
+		}
+		if r.unbound {
+			return nil, false
+		}
+		attrs = append(attrs, r.attrs...)
+	}
+	if len(attrs) == 0 {
+		return m, true
+	}
+
+	cp := m.cfg.rebind(m.attrs, m.set, attrs)
+	return newMeter(m.Meter, cp, m.cfg.bound(attribute.NewSet(cp...)), m.cfg), true
+}
+
+func newMeter(m metric.Meter, attrs []attribute.KeyValue, set attribute.Set, cfg *config) *meter {
 	o := metric.WithAttributeSet(set)
 	return &meter{
 		Meter:  m,
-		attrs:  cp,
+		attrs:  attrs,
 		set:    set,
 		addOpt: []metric.AddOption{o},
 		recOpt: []metric.RecordOption{o},
+		obsOpt: []metric.ObserveOption{o},
+		cfg:    cfg,
 	}
 }
 
@@ -45,6 +121,8 @@ type meter struct {
 	set    attribute.Set
 	addOpt []metric.AddOption
 	recOpt []metric.RecordOption
+	obsOpt []metric.ObserveOption
+	cfg    *config
 }
 
 var (
@@ -57,105 +135,331 @@ func (m *meter) Unwrap() (metric.Meter, attribute.Set) {
 }
 
 func (m *meter) Int64Counter(name string, options ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindInt64Counter), options)
+	b, ok := m.binding(name, KindInt64Counter)
 	inst, err := m.Meter.Int64Counter(name, options...)
-	if inst != nil {
+	if inst != nil && ok {
 		inst = int64Counter{
 			inst:  inst,
-			attrs: m.attrs,
-			set:   m.set,
-			o:     m.addOpt,
+			attrs: b.attrs,
+			set:   b.set,
+			o:     b.addOpt,
+			p:     meterPipeline(b.cfg),
 		}
 	}
 	return inst, err
 }
 
 func (m *meter) Int64UpDownCounter(name string, options ...metric.Int64UpDownCounterOption) (metric.Int64UpDownCounter, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindInt64UpDownCounter), options)
+	b, ok := m.binding(name, KindInt64UpDownCounter)
 	inst, err := m.Meter.Int64UpDownCounter(name, options...)
-	if inst != nil {
+	if inst != nil && ok {
 		inst = int64UpDownCounter{
 			inst:  inst,
-			attrs: m.attrs,
-			set:   m.set,
-			o:     m.addOpt,
+			attrs: b.attrs,
+			set:   b.set,
+			o:     b.addOpt,
+			p:     meterPipeline(b.cfg),
 		}
 	}
 	return inst, err
 }
 
 func (m *meter) Int64Histogram(name string, options ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindInt64Histogram), options)
+	b, ok := m.binding(name, KindInt64Histogram)
 	inst, err := m.Meter.Int64Histogram(name, options...)
-	if inst != nil {
+	if inst != nil && ok {
 		inst = int64Histogram{
 			inst:  inst,
-			attrs: m.attrs,
-			set:   m.set,
-			o:     m.recOpt,
+			attrs: b.attrs,
+			set:   b.set,
+			o:     b.recOpt,
+			p:     meterPipeline(b.cfg),
 		}
 	}
 	return inst, err
 }
 
 func (m *meter) Int64Gauge(name string, options ...metric.Int64GaugeOption) (metric.Int64Gauge, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindInt64Gauge), options)
+	b, ok := m.binding(name, KindInt64Gauge)
 	inst, err := m.Meter.Int64Gauge(name, options...)
-	if inst != nil {
+	if inst != nil && ok {
 		inst = int64Gauge{
 			inst:  inst,
-			attrs: m.attrs,
-			set:   m.set,
-			o:     m.recOpt,
+			attrs: b.attrs,
+			set:   b.set,
+			o:     b.recOpt,
+			p:     meterPipeline(b.cfg),
 		}
 	}
 	return inst, err
 }
 
 func (m *meter) Float64Counter(name string, options ...metric.Float64CounterOption) (metric.Float64Counter, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindFloat64Counter), options)
+	b, ok := m.binding(name, KindFloat64Counter)
 	inst, err := m.Meter.Float64Counter(name, options...)
-	if inst != nil {
+	if inst != nil && ok {
 		inst = float64Counter{
 			inst:  inst,
-			attrs: m.attrs,
-			set:   m.set,
-			o:     m.addOpt,
+			attrs: b.attrs,
+			set:   b.set,
+			o:     b.addOpt,
+			p:     meterPipeline(b.cfg),
 		}
 	}
 	return inst, err
 }
 
 func (m *meter) Float64UpDownCounter(name string, options ...metric.Float64UpDownCounterOption) (metric.Float64UpDownCounter, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindFloat64UpDownCounter), options)
+	b, ok := m.binding(name, KindFloat64UpDownCounter)
 	inst, err := m.Meter.Float64UpDownCounter(name, options...)
-	if inst != nil {
+	if inst != nil && ok {
 		inst = float64UpDownCounter{
 			inst:  inst,
-			attrs: m.attrs,
-			set:   m.set,
-			o:     m.addOpt,
+			attrs: b.attrs,
+			set:   b.set,
+			o:     b.addOpt,
+			p:     meterPipeline(b.cfg),
 		}
 	}
 	return inst, err
 }
 
 func (m *meter) Float64Histogram(name string, options ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindFloat64Histogram), options)
+	b, ok := m.binding(name, KindFloat64Histogram)
 	inst, err := m.Meter.Float64Histogram(name, options...)
-	if inst != nil {
+	if inst != nil && ok {
 		inst = float64Histogram{
 			inst:  inst,
-			attrs: m.attrs,
-			set:   m.set,
-			o:     m.recOpt,
+			attrs: b.attrs,
+			set:   b.set,
+			o:     b.recOpt,
+			p:     meterPipeline(b.cfg),
 		}
 	}
 	return inst, err
 }
 
 func (m *meter) Float64Gauge(name string, options ...metric.Float64GaugeOption) (metric.Float64Gauge, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindFloat64Gauge), options)
+	b, ok := m.binding(name, KindFloat64Gauge)
 	inst, err := m.Meter.Float64Gauge(name, options...)
-	if inst != nil {
+	if inst != nil && ok {
 		inst = float64Gauge{
 			inst:  inst,
-			attrs: m.attrs,
-			set:   m.set,
-			o:     m.recOpt,
+			attrs: b.attrs,
+			set:   b.set,
+			o:     b.recOpt,
+			p:     meterPipeline(b.cfg),
+		}
+	}
+	return inst, err
+}
+
+func (m *meter) Int64ObservableCounter(name string, options ...metric.Int64ObservableCounterOption) (metric.Int64ObservableCounter, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindInt64ObservableCounter), options)
+	b, ok := m.binding(name, KindInt64ObservableCounter)
+	if !ok {
+		return m.Meter.Int64ObservableCounter(name, options...)
+	}
+
+	cfg := metric.NewInt64ObservableCounterConfig(options...)
+	if cbs := cfg.Callbacks(); len(cbs) > 0 {
+		// Replace the callbacks with ones that observe the bound attributes.
+		options = make([]metric.Int64ObservableCounterOption, 0, len(cbs)+2)
+		options = append(options, metric.WithDescription(cfg.Description()), metric.WithUnit(cfg.Unit()))
+		for _, cb := range cbs {
+			options = append(options, metric.WithInt64Callback(bindInt64Callback(cb, b.obsOpt)))
+		}
+	}
+
+	inst, err := m.Meter.Int64ObservableCounter(name, options...)
+	if inst != nil {
+		inst = int64ObservableCounter{
+			Int64ObservableCounter: inst,
+			set:                    b.set,
+			o:                      b.obsOpt,
+		}
+	}
+	return inst, err
+}
+
+func (m *meter) Int64ObservableUpDownCounter(name string, options ...metric.Int64ObservableUpDownCounterOption) (metric.Int64ObservableUpDownCounter, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindInt64ObservableUpDownCounter), options)
+	b, ok := m.binding(name, KindInt64ObservableUpDownCounter)
+	if !ok {
+		return m.Meter.Int64ObservableUpDownCounter(name, options...)
+	}
+
+	cfg := metric.NewInt64ObservableUpDownCounterConfig(options...)
+	if cbs := cfg.Callbacks(); len(cbs) > 0 {
+		// Replace the callbacks with ones that observe the bound attributes.
+		options = make([]metric.Int64ObservableUpDownCounterOption, 0, len(cbs)+2)
+		options = append(options, metric.WithDescription(cfg.Description()), metric.WithUnit(cfg.Unit()))
+		for _, cb := range cbs {
+			options = append(options, metric.WithInt64Callback(bindInt64Callback(cb, b.obsOpt)))
+		}
+	}
+
+	inst, err := m.Meter.Int64ObservableUpDownCounter(name, options...)
+	if inst != nil {
+		inst = int64ObservableUpDownCounter{
+			Int64ObservableUpDownCounter: inst,
+			set:                          b.set,
+			o:                            b.obsOpt,
 		}
 	}
 	return inst, err
 }
+
+func (m *meter) Int64ObservableGauge(name string, options ...metric.Int64ObservableGaugeOption) (metric.Int64ObservableGauge, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindInt64ObservableGauge), options)
+	b, ok := m.binding(name, KindInt64ObservableGauge)
+	if !ok {
+		return m.Meter.Int64ObservableGauge(name, options...)
+	}
+
+	cfg := metric.NewInt64ObservableGaugeConfig(options...)
+	if cbs := cfg.Callbacks(); len(cbs) > 0 {
+		// Replace the callbacks with ones that observe the bound attributes.
+		options = make([]metric.Int64ObservableGaugeOption, 0, len(cbs)+2)
+		options = append(options, metric.WithDescription(cfg.Description()), metric.WithUnit(cfg.Unit()))
+		for _, cb := range cbs {
+			options = append(options, metric.WithInt64Callback(bindInt64Callback(cb, b.obsOpt)))
+		}
+	}
+
+	inst, err := m.Meter.Int64ObservableGauge(name, options...)
+	if inst != nil {
+		inst = int64ObservableGauge{
+			Int64ObservableGauge: inst,
+			set:                  b.set,
+			o:                    b.obsOpt,
+		}
+	}
+	return inst, err
+}
+
+func (m *meter) Float64ObservableCounter(name string, options ...metric.Float64ObservableCounterOption) (metric.Float64ObservableCounter, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindFloat64ObservableCounter), options)
+	b, ok := m.binding(name, KindFloat64ObservableCounter)
+	if !ok {
+		return m.Meter.Float64ObservableCounter(name, options...)
+	}
+
+	cfg := metric.NewFloat64ObservableCounterConfig(options...)
+	if cbs := cfg.Callbacks(); len(cbs) > 0 {
+		// Replace the callbacks with ones that observe the bound attributes.
+		options = make([]metric.Float64ObservableCounterOption, 0, len(cbs)+2)
+		options = append(options, metric.WithDescription(cfg.Description()), metric.WithUnit(cfg.Unit()))
+		for _, cb := range cbs {
+			options = append(options, metric.WithFloat64Callback(bindFloat64Callback(cb, b.obsOpt)))
+		}
+	}
+
+	inst, err := m.Meter.Float64ObservableCounter(name, options...)
+	if inst != nil {
+		inst = float64ObservableCounter{
+			Float64ObservableCounter: inst,
+			set:                      b.set,
+			o:                        b.obsOpt,
+		}
+	}
+	return inst, err
+}
+
+func (m *meter) Float64ObservableUpDownCounter(name string, options ...metric.Float64ObservableUpDownCounterOption) (metric.Float64ObservableUpDownCounter, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindFloat64ObservableUpDownCounter), options)
+	b, ok := m.binding(name, KindFloat64ObservableUpDownCounter)
+	if !ok {
+		return m.Meter.Float64ObservableUpDownCounter(name, options...)
+	}
+
+	cfg := metric.NewFloat64ObservableUpDownCounterConfig(options...)
+	if cbs := cfg.Callbacks(); len(cbs) > 0 {
+		// Replace the callbacks with ones that observe the bound attributes.
+		options = make([]metric.Float64ObservableUpDownCounterOption, 0, len(cbs)+2)
+		options = append(options, metric.WithDescription(cfg.Description()), metric.WithUnit(cfg.Unit()))
+		for _, cb := range cbs {
+			options = append(options, metric.WithFloat64Callback(bindFloat64Callback(cb, b.obsOpt)))
+		}
+	}
+
+	inst, err := m.Meter.Float64ObservableUpDownCounter(name, options...)
+	if inst != nil {
+		inst = float64ObservableUpDownCounter{
+			Float64ObservableUpDownCounter: inst,
+			set:                            b.set,
+			o:                              b.obsOpt,
+		}
+	}
+	return inst, err
+}
+
+func (m *meter) Float64ObservableGauge(name string, options ...metric.Float64ObservableGaugeOption) (metric.Float64ObservableGauge, error) {
+	options = applyDefaults(m.cfg.defaults(name, KindFloat64ObservableGauge), options)
+	b, ok := m.binding(name, KindFloat64ObservableGauge)
+	if !ok {
+		return m.Meter.Float64ObservableGauge(name, options...)
+	}
+
+	cfg := metric.NewFloat64ObservableGaugeConfig(options...)
+	if cbs := cfg.Callbacks(); len(cbs) > 0 {
+		// Replace the callbacks with ones that observe the bound attributes.
+		options = make([]metric.Float64ObservableGaugeOption, 0, len(cbs)+2)
+		options = append(options, metric.WithDescription(cfg.Description()), metric.WithUnit(cfg.Unit()))
+		for _, cb := range cbs {
+			options = append(options, metric.WithFloat64Callback(bindFloat64Callback(cb, b.obsOpt)))
+		}
+	}
+
+	inst, err := m.Meter.Float64ObservableGauge(name, options...)
+	if inst != nil {
+		inst = float64ObservableGauge{
+			Float64ObservableGauge: inst,
+			set:                    b.set,
+			o:                      b.obsOpt,
+		}
+	}
+	return inst, err
+}
+
+// RegisterCallback registers f to be called during the collection of a
+// measurement cycle. The [metric.Observer] passed to f will include the bound
+// attributes of any bound instrument it observes.
+func (m *meter) RegisterCallback(f metric.Callback, instruments ...metric.Observable) (metric.Registration, error) {
+	return registerCallback(m.Meter, f, instruments)
+}
+
+// registerCallback registers f with m for instruments. Bound instruments are
+// unwrapped, and the [metric.Observer] passed to f includes the bound
+// attributes of any bound instrument it observes.
+func registerCallback(m metric.Meter, f metric.Callback, instruments []metric.Observable) (metric.Registration, error) {
+	insts := make([]metric.Observable, len(instruments))
+	for i, inst := range instruments {
+		// The underlying Meter only knows about the instruments it created.
+		switch b := inst.(type) {
+		case boundInt64Observable:
+			insts[i], _ = b.base()
+		case boundFloat64Observable:
+			insts[i], _ = b.base()
+		default:
+			insts[i] = inst
+		}
+	}
+
+	cb := func(ctx context.Context, o metric.Observer) error {
+		return f(ctx, observer{obs: o})
+	}
+	reg, err := m.RegisterCallback(cb, insts...)
+	if reg != nil {
+		reg = registration{reg: reg}
+	}
+	return reg, err
+}
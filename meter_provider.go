@@ -0,0 +1,164 @@
+package bind
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+// MeterProvider binds attrs to mp. All [metric.Meter] returned by the
+// returned [metric.MeterProvider] will be bound to attrs (see [Meter]).
+//
+// If mp is already bound to attributes, attrs will be merged into those
+// attributes for the returned provider.
+func MeterProvider(mp metric.MeterProvider, attrs ...attribute.KeyValue) metric.MeterProvider {
+	if len(attrs) == 0 {
+		return mp
+	}
+	return bindMeterProvider(mp, scopeRule{attrs: attrs})
+}
+
+// ScopedMeterProvider binds attrs to mp for all [metric.Meter] with an
+// instrumentation scope name that matches pattern. The pattern is matched
+// against the complete scope name. The wildcard '*' matches any sequence of
+// characters, all other characters match themselves. A pattern without a
+// wildcard is an exact match.
+//
+// If mp is already bound to attributes, the returned provider will bind attrs
+// in addition to those attributes. When a scope name matches multiple
+// bindings, attributes are merged in the order they were bound.
+func ScopedMeterProvider(mp metric.MeterProvider, pattern string, attrs ...attribute.KeyValue) metric.MeterProvider {
+	if len(attrs) == 0 {
+		return mp
+	}
+	g := newGlob(pattern)
+	return bindMeterProvider(mp, scopeRule{glob: &g, attrs: attrs})
+}
+
+// scopeRule is a set of attributes bound to scopes matching glob. If glob is
+// nil, the attributes are bound to all scopes.
+type scopeRule struct {
+	glob  *glob
+	attrs []attribute.KeyValue
+}
+
+func (r scopeRule) match(name string) bool {
+	return r.glob == nil || r.glob.match(name)
+}
+
+func bindMeterProvider(mp metric.MeterProvider, rule scopeRule) *meterProvider {
+	// NewSet sorts passed attributes. Copy to avoid side effect.
+	cp := make([]attribute.KeyValue, len(rule.attrs))
+	copy(cp, rule.attrs)
+	rule.attrs = cp
+
+	var (
+		rules []scopeRule
+		opts  []Option
+	)
+	if p, ok := mp.(*meterProvider); ok {
+		// Flatten the provider if already bound.
+		mp = p.mp
+		rules = make([]scopeRule, 0, len(p.rules)+1)
+		rules = append(rules, p.rules...)
+		opts = p.opts
+	}
+	rules = append(rules, rule)
+
+	var all []attribute.KeyValue
+	for _, r := range rules {
+		if r.glob == nil {
+			all = append(all, r.attrs...)
+		}
+	}
+
+	return &meterProvider{
+		mp:    mp,
+		rules: rules,
+		set:   attribute.NewSet(all...),
+		opts:  opts,
+	}
+}
+
+// configureMeterProvider returns mp configured with opts.
+func configureMeterProvider(mp metric.MeterProvider, opts []Option) metric.MeterProvider {
+	p, ok := mp.(*meterProvider)
+	if !ok {
+		p = &meterProvider{mp: mp, set: *attribute.EmptySet()}
+	}
+
+	cp := *p
+	cp.opts = make([]Option, 0, len(p.opts)+len(opts))
+	cp.opts = append(cp.opts, p.opts...)
+	cp.opts = append(cp.opts, opts...)
+	return &cp
+}
+
+// withoutMeterProvider returns mp without the bound attributes with keys.
+func withoutMeterProvider(mp metric.MeterProvider, keys []attribute.Key) metric.MeterProvider {
+	p, ok := mp.(*meterProvider)
+	if !ok {
+		return mp
+	}
+
+	var (
+		rules []scopeRule
+		all   []attribute.KeyValue
+	)
+	for _, r := range p.rules {
+		r.attrs = without(r.attrs, keys)
+		if len(r.attrs) == 0 {
+			continue
+		}
+		rules = append(rules, r)
+		if r.glob == nil {
+			all = append(all, r.attrs...)
+		}
+	}
+	if len(rules) == 0 && len(p.opts) == 0 {
+		return p.mp
+	}
+
+	return &meterProvider{
+		mp:    p.mp,
+		rules: rules,
+		set:   attribute.NewSet(all...),
+		opts:  p.opts,
+	}
+}
+
+type meterProvider struct {
+	embedded.MeterProvider
+
+	mp    metric.MeterProvider
+	rules []scopeRule
+	// set is the attributes bound to all scopes.
+	set attribute.Set
+	// opts are the options all returned Meters are configured with.
+	opts []Option
+}
+
+var (
+	_ metric.MeterProvider            = (*meterProvider)(nil)
+	_ unwrapper[metric.MeterProvider] = (*meterProvider)(nil)
+)
+
+// Unwrap returns the underlying [metric.MeterProvider] and the attributes
+// bound to all scopes. Attributes bound with [ScopedMeterProvider] are not
+// included.
+func (p *meterProvider) Unwrap() (metric.MeterProvider, attribute.Set) {
+	return p.mp, p.set
+}
+
+// Meter returns a [metric.Meter] from the underlying provider bound to all
+// attributes that apply to the name instrumentation scope and configured with
+// the provider's options.
+func (p *meterProvider) Meter(name string, opts ...metric.MeterOption) metric.Meter {
+	var attrs []attribute.KeyValue
+	for _, r := range p.rules {
+		if r.match(name) {
+			attrs = append(attrs, r.attrs...)
+		}
+	}
+	return Configure(Meter(p.mp.Meter(name, opts...), attrs...), p.opts...)
+}
@@ -0,0 +1,143 @@
+package bind_test
+
+import (
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// mockMeterProvider is a mock meter provider for testing.
+type mockMeterProvider struct {
+	noop.MeterProvider
+
+	meters map[string]*mockMeter
+}
+
+func (p *mockMeterProvider) Meter(name string, _ ...metric.MeterOption) metric.Meter {
+	if p.meters == nil {
+		p.meters = make(map[string]*mockMeter)
+	}
+	m, ok := p.meters[name]
+	if !ok {
+		m = &mockMeter{}
+		p.meters[name] = m
+	}
+	return m
+}
+
+func meterAttrs(t *testing.T, m metric.Meter) []attribute.KeyValue {
+	t.Helper()
+
+	_, set := bind.Unwrap(m)
+	return set.ToSlice()
+}
+
+func TestMeterProviderEmptyAttrs(t *testing.T) {
+	mock := &mockMeterProvider{}
+	assert.Same(t, mock, bind.MeterProvider(mock), "provider with no attributes should return the same provider")
+	assert.Same(t, mock, bind.ScopedMeterProvider(mock, "*"), "provider with no attributes should return the same provider")
+}
+
+func TestMeterProvider(t *testing.T) {
+	mock := &mockMeterProvider{}
+	mp := bind.MeterProvider(mock, userAlice)
+	require.NotNil(t, mp)
+
+	m := mp.Meter("scope")
+	got, _ := bind.Unwrap(m)
+	assert.Same(t, mock.meters["scope"], got, "meter should wrap the underlying meter")
+	assert.Equal(t, []attribute.KeyValue{userAlice}, meterAttrs(t, m))
+
+	inst, err := m.Int64Counter("counter")
+	require.NoError(t, err)
+	_, set := bind.Unwrap(inst)
+	assert.Equal(t, []attribute.KeyValue{userAlice}, set.ToSlice(), "instrument attributes")
+}
+
+func TestScopedMeterProvider(t *testing.T) {
+	mock := &mockMeterProvider{}
+
+	mp := bind.MeterProvider(mock, userAlice)
+	mp = bind.ScopedMeterProvider(mp, "go.opentelemetry.io/contrib/*", userID)
+	mp = bind.ScopedMeterProvider(mp, "exact", adminTrue)
+
+	assert.Equal(t, []attribute.KeyValue{userAlice}, meterAttrs(t, mp.Meter("other")))
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, meterAttrs(t, mp.Meter("go.opentelemetry.io/contrib/net/http")))
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, adminTrue}, meterAttrs(t, mp.Meter("exact")))
+	assert.Equal(t, []attribute.KeyValue{userAlice}, meterAttrs(t, mp.Meter("exactly")))
+}
+
+func TestScopedMeterProviderUnmatched(t *testing.T) {
+	mock := &mockMeterProvider{}
+	mp := bind.ScopedMeterProvider(mock, "scope", userAlice)
+
+	m := mp.Meter("other")
+	assert.Same(t, mock.meters["other"], m, "unmatched scope should not be bound")
+}
+
+func TestScopedMeterProviderPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"", "", true},
+		{"", "a", false},
+		{"abc", "abc", true},
+		{"abc", "abcd", false},
+		{"*", "", true},
+		{"*", "go.opentelemetry.io/contrib", true},
+		{"go.opentelemetry.io/*", "go.opentelemetry.io/contrib/net/http", true},
+		{"go.opentelemetry.io/*", "go.opentelemetry.io", false},
+		{"*/otelhttp", "go.opentelemetry.io/contrib/otelhttp", true},
+		{"*/otelhttp", "go.opentelemetry.io/contrib/otelgrpc", false},
+		{"a*b*c", "abc", true},
+		{"a*b*c", "aXbYc", true},
+		{"a*b*c", "acb", false},
+		{"a*a", "a", false},
+		{"**", "anything", true},
+	}
+
+	for _, test := range tests {
+		mp := bind.ScopedMeterProvider(&mockMeterProvider{}, test.pattern, userAlice)
+		_, set := bind.Unwrap(mp.Meter(test.name))
+		assert.Equalf(t, test.want, set.Len() > 0, "%q matching %q", test.pattern, test.name)
+	}
+}
+
+func TestMeterProviderFlatten(t *testing.T) {
+	mock := &mockMeterProvider{}
+
+	mp := bind.MeterProvider(mock, userAlice)
+	mp = bind.ScopedMeterProvider(mp, "*", adminTrue)
+	mp = bind.MeterProvider(mp, userID)
+
+	got, set := bind.Unwrap(mp)
+	assert.Same(t, mock, got, "underlying provider should be the original mock provider")
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, set.ToSlice(), "unscoped attributes")
+
+	m := mp.Meter("scope")
+	inner, _ := bind.Unwrap(m)
+	assert.Same(t, mock.meters["scope"], inner, "meter should not be bound more than once")
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID, adminTrue}, meterAttrs(t, m))
+}
+
+func TestMeterProviderNoSideEffects(t *testing.T) {
+	mock := &mockMeterProvider{}
+
+	a, cpA := clone(attribute.Int("C", 3), attribute.Int("B", 2))
+	b, cpB := clone(attribute.Int("D", 4), attribute.Int("A", 1))
+
+	mp := bind.MeterProvider(mock, a...)
+	assert.Equal(t, cpA, a, "original attributes should not be modified")
+
+	mp = bind.ScopedMeterProvider(mp, "*", b...)
+	_ = mp.Meter("scope")
+	assert.Equal(t, cpA, a, "original attributes should not be modified after second bind")
+	assert.Equal(t, cpB, b, "second set of attributes should not be modified")
+}
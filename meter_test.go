@@ -16,6 +16,13 @@ type mockMeter struct {
 	noop.Meter
 
 	err error
+
+	int64Callbacks   []metric.Int64Callback
+	float64Callbacks []metric.Float64Callback
+
+	callback metric.Callback
+	insts    []metric.Observable
+	reg      *mockRegistration
 }
 
 func (m *mockMeter) Int64Counter(n string, o ...metric.Int64CounterOption) (metric.Int64Counter, error) {
@@ -50,6 +57,45 @@ func (m *mockMeter) Float64Gauge(n string, o ...metric.Float64GaugeOption) (metr
 	return errOr(mockFloat64Gauge{name: n, instOpts: o}, m.err)
 }
 
+func (m *mockMeter) Int64ObservableCounter(n string, o ...metric.Int64ObservableCounterOption) (metric.Int64ObservableCounter, error) {
+	m.int64Callbacks = metric.NewInt64ObservableCounterConfig(o...).Callbacks()
+	return errOr(mockInt64ObservableCounter{name: n}, m.err)
+}
+
+func (m *mockMeter) Int64ObservableUpDownCounter(n string, o ...metric.Int64ObservableUpDownCounterOption) (metric.Int64ObservableUpDownCounter, error) {
+	m.int64Callbacks = metric.NewInt64ObservableUpDownCounterConfig(o...).Callbacks()
+	return errOr(mockInt64ObservableUpDownCounter{name: n}, m.err)
+}
+
+func (m *mockMeter) Int64ObservableGauge(n string, o ...metric.Int64ObservableGaugeOption) (metric.Int64ObservableGauge, error) {
+	m.int64Callbacks = metric.NewInt64ObservableGaugeConfig(o...).Callbacks()
+	return errOr(mockInt64ObservableGauge{name: n}, m.err)
+}
+
+func (m *mockMeter) Float64ObservableCounter(n string, o ...metric.Float64ObservableCounterOption) (metric.Float64ObservableCounter, error) {
+	m.float64Callbacks = metric.NewFloat64ObservableCounterConfig(o...).Callbacks()
+	return errOr(mockFloat64ObservableCounter{name: n}, m.err)
+}
+
+func (m *mockMeter) Float64ObservableUpDownCounter(n string, o ...metric.Float64ObservableUpDownCounterOption) (metric.Float64ObservableUpDownCounter, error) {
+	m.float64Callbacks = metric.NewFloat64ObservableUpDownCounterConfig(o...).Callbacks()
+	return errOr(mockFloat64ObservableUpDownCounter{name: n}, m.err)
+}
+
+func (m *mockMeter) Float64ObservableGauge(n string, o ...metric.Float64ObservableGaugeOption) (metric.Float64ObservableGauge, error) {
+	m.float64Callbacks = metric.NewFloat64ObservableGaugeConfig(o...).Callbacks()
+	return errOr(mockFloat64ObservableGauge{name: n}, m.err)
+}
+
+func (m *mockMeter) RegisterCallback(f metric.Callback, insts ...metric.Observable) (metric.Registration, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.callback, m.insts = f, insts
+	m.reg = &mockRegistration{}
+	return m.reg, nil
+}
+
 func errOr[T any](t T, err error) (*T, error) {
 	if err != nil {
 		return nil, err
@@ -159,6 +205,30 @@ func TestMeterInstruments(t *testing.T) {
 	}))
 }
 
+func TestMeterObservableInstruments(t *testing.T) {
+	const name = "test_instrument"
+
+	t.Run("Int64ObservableCounter", testMeterInst(func(m metric.Meter) (metric.Int64ObservableCounter, error) {
+		return m.Int64ObservableCounter(name)
+	}))
+	t.Run("Int64ObservableUpDownCounter", testMeterInst(func(m metric.Meter) (metric.Int64ObservableUpDownCounter, error) {
+		return m.Int64ObservableUpDownCounter(name)
+	}))
+	t.Run("Int64ObservableGauge", testMeterInst(func(m metric.Meter) (metric.Int64ObservableGauge, error) {
+		return m.Int64ObservableGauge(name)
+	}))
+
+	t.Run("Float64ObservableCounter", testMeterInst(func(m metric.Meter) (metric.Float64ObservableCounter, error) {
+		return m.Float64ObservableCounter(name)
+	}))
+	t.Run("Float64ObservableUpDownCounter", testMeterInst(func(m metric.Meter) (metric.Float64ObservableUpDownCounter, error) {
+		return m.Float64ObservableUpDownCounter(name)
+	}))
+	t.Run("Float64ObservableGauge", testMeterInst(func(m metric.Meter) (metric.Float64ObservableGauge, error) {
+		return m.Float64ObservableGauge(name)
+	}))
+}
+
 func BenchmarkMeter(b *testing.B) {
 	run := func(m metric.Meter) func(*testing.B) {
 		return func(b *testing.B) {
@@ -0,0 +1,147 @@
+package bind
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+// boundInt64Observable is implemented by all bound int64 asynchronous
+// instruments.
+type boundInt64Observable interface {
+	base() (metric.Int64Observable, []metric.ObserveOption)
+}
+
+// boundFloat64Observable is implemented by all bound float64 asynchronous
+// instruments.
+type boundFloat64Observable interface {
+	base() (metric.Float64Observable, []metric.ObserveOption)
+}
+
+// observer wraps a [metric.Observer] so observations made for bound
+// asynchronous instruments include the attributes bound to the instrument.
+type observer struct {
+	embedded.Observer
+
+	obs metric.Observer
+}
+
+var _ metric.Observer = observer{}
+
+// ObserveInt64 records the int64 value for obsrv. If obsrv is a bound
+// instrument, the observation will include the attributes bound to it.
+func (o observer) ObserveInt64(obsrv metric.Int64Observable, value int64, opts ...metric.ObserveOption) {
+	b, ok := obsrv.(boundInt64Observable)
+	if !ok {
+		o.obs.ObserveInt64(obsrv, value, opts...)
+		return
+	}
+
+	inst, bound := b.base()
+	observe(bound, opts, func(opts ...metric.ObserveOption) {
+		o.obs.ObserveInt64(inst, value, opts...)
+	})
+}
+
+// ObserveFloat64 records the float64 value for obsrv. If obsrv is a bound
+// instrument, the observation will include the attributes bound to it.
+func (o observer) ObserveFloat64(obsrv metric.Float64Observable, value float64, opts ...metric.ObserveOption) {
+	b, ok := obsrv.(boundFloat64Observable)
+	if !ok {
+		o.obs.ObserveFloat64(obsrv, value, opts...)
+		return
+	}
+
+	inst, bound := b.base()
+	observe(bound, opts, func(opts ...metric.ObserveOption) {
+		o.obs.ObserveFloat64(inst, value, opts...)
+	})
+}
+
+// int64Observer wraps a [metric.Int64Observer] passed to a callback of a
+// bound instrument so all observations include the bound attributes.
+type int64Observer struct {
+	embedded.Int64Observer
+
+	obs metric.Int64Observer
+	o   []metric.ObserveOption
+}
+
+var _ metric.Int64Observer = int64Observer{}
+
+// Observe records the int64 value. All observations made will include the
+// attributes bound to the instrument.
+func (o int64Observer) Observe(value int64, opts ...metric.ObserveOption) {
+	observe(o.o, opts, func(opts ...metric.ObserveOption) {
+		o.obs.Observe(value, opts...)
+	})
+}
+
+// bindInt64Callback returns a [metric.Int64Callback] that calls cb with an
+// observer that includes the bound options in all observations.
+func bindInt64Callback(cb metric.Int64Callback, o []metric.ObserveOption) metric.Int64Callback {
+	return func(ctx context.Context, obs metric.Int64Observer) error {
+		return cb(ctx, int64Observer{obs: obs, o: o})
+	}
+}
+
+// float64Observer wraps a [metric.Float64Observer] passed to a callback of a
+// bound instrument so all observations include the bound attributes.
+type float64Observer struct {
+	embedded.Float64Observer
+
+	obs metric.Float64Observer
+	o   []metric.ObserveOption
+}
+
+var _ metric.Float64Observer = float64Observer{}
+
+// Observe records the float64 value. All observations made will include the
+// attributes bound to the instrument.
+func (o float64Observer) Observe(value float64, opts ...metric.ObserveOption) {
+	observe(o.o, opts, func(opts ...metric.ObserveOption) {
+		o.obs.Observe(value, opts...)
+	})
+}
+
+// bindFloat64Callback returns a [metric.Float64Callback] that calls cb with
+// an observer that includes the bound options in all observations.
+func bindFloat64Callback(cb metric.Float64Callback, o []metric.ObserveOption) metric.Float64Callback {
+	return func(ctx context.Context, obs metric.Float64Observer) error {
+		return cb(ctx, float64Observer{obs: obs, o: o})
+	}
+}
+
+// observe calls fn with the bound options followed by opts.
+func observe(bound, opts []metric.ObserveOption, fn func(...metric.ObserveOption)) {
+	if len(opts) == 0 {
+		fn(bound...)
+		return
+	}
+
+	o := observeOptPool.Get().(*[]metric.ObserveOption)
+	defer func() {
+		*o = (*o)[:0]
+		observeOptPool.Put(o)
+	}()
+
+	*o = append(*o, bound...)
+	*o = append(*o, opts...)
+	fn(*o...)
+}
+
+// registration wraps the [metric.Registration] of a callback registered with
+// a bound [metric.Meter].
+type registration struct {
+	embedded.Registration
+
+	reg metric.Registration
+}
+
+var _ metric.Registration = registration{}
+
+// Unregister removes the callback registration from the underlying Meter.
+func (r registration) Unregister() error {
+	return r.reg.Unregister()
+}
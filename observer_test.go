@@ -0,0 +1,229 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+type mockInt64ObservableCounter struct {
+	noop.Int64ObservableCounter
+
+	name string
+}
+
+type mockInt64ObservableUpDownCounter struct {
+	noop.Int64ObservableUpDownCounter
+
+	name string
+}
+
+type mockInt64ObservableGauge struct {
+	noop.Int64ObservableGauge
+
+	name string
+}
+
+type mockFloat64ObservableCounter struct {
+	noop.Float64ObservableCounter
+
+	name string
+}
+
+type mockFloat64ObservableUpDownCounter struct {
+	noop.Float64ObservableUpDownCounter
+
+	name string
+}
+
+type mockFloat64ObservableGauge struct {
+	noop.Float64ObservableGauge
+
+	name string
+}
+
+type mockRegistration struct {
+	embedded.Registration
+
+	unregistered bool
+}
+
+func (r *mockRegistration) Unregister() error {
+	r.unregistered = true
+	return nil
+}
+
+type observation struct {
+	inst  metric.Observable
+	value float64
+	attrs []attribute.KeyValue
+}
+
+func newObservation(inst metric.Observable, val float64, opts []metric.ObserveOption) observation {
+	set := metric.NewObserveConfig(opts).Attributes()
+	return observation{inst: inst, value: val, attrs: set.ToSlice()}
+}
+
+type mockObserver struct {
+	embedded.Observer
+
+	got []observation
+}
+
+func (o *mockObserver) ObserveInt64(inst metric.Int64Observable, val int64, opts ...metric.ObserveOption) {
+	o.got = append(o.got, newObservation(inst, float64(val), opts))
+}
+
+func (o *mockObserver) ObserveFloat64(inst metric.Float64Observable, val float64, opts ...metric.ObserveOption) {
+	o.got = append(o.got, newObservation(inst, val, opts))
+}
+
+type mockInt64Observer struct {
+	embedded.Int64Observer
+
+	got []observation
+}
+
+func (o *mockInt64Observer) Observe(val int64, opts ...metric.ObserveOption) {
+	o.got = append(o.got, newObservation(nil, float64(val), opts))
+}
+
+type mockFloat64Observer struct {
+	embedded.Float64Observer
+
+	got []observation
+}
+
+func (o *mockFloat64Observer) Observe(val float64, opts ...metric.ObserveOption) {
+	o.got = append(o.got, newObservation(nil, val, opts))
+}
+
+func TestMeterInt64Callback(t *testing.T) {
+	mock := &mockMeter{}
+	meter := bind.Meter(mock, userAlice)
+
+	cb := func(_ context.Context, o metric.Int64Observer) error {
+		o.Observe(1)
+		o.Observe(2, metric.WithAttributes(adminTrue))
+		return nil
+	}
+	_, err := meter.Int64ObservableCounter("counter", metric.WithInt64Callback(cb))
+	require.NoError(t, err)
+	require.Len(t, mock.int64Callbacks, 1)
+
+	obs := &mockInt64Observer{}
+	require.NoError(t, mock.int64Callbacks[0](context.Background(), obs))
+
+	want := []observation{
+		{value: 1, attrs: []attribute.KeyValue{userAlice}},
+		{value: 2, attrs: []attribute.KeyValue{adminTrue, userAlice}},
+	}
+	assert.Equal(t, want, obs.got)
+}
+
+func TestMeterFloat64Callback(t *testing.T) {
+	mock := &mockMeter{}
+	meter := bind.Meter(mock, userAlice)
+
+	cb := func(_ context.Context, o metric.Float64Observer) error {
+		o.Observe(1.5)
+		o.Observe(2.5, metric.WithAttributes(adminTrue))
+		return nil
+	}
+	_, err := meter.Float64ObservableGauge("gauge", metric.WithFloat64Callback(cb))
+	require.NoError(t, err)
+	require.Len(t, mock.float64Callbacks, 1)
+
+	obs := &mockFloat64Observer{}
+	require.NoError(t, mock.float64Callbacks[0](context.Background(), obs))
+
+	want := []observation{
+		{value: 1.5, attrs: []attribute.KeyValue{userAlice}},
+		{value: 2.5, attrs: []attribute.KeyValue{adminTrue, userAlice}},
+	}
+	assert.Equal(t, want, obs.got)
+}
+
+func TestMeterCallbackKeepsConfig(t *testing.T) {
+	var got metric.Int64ObservableCounterConfig
+	mock := &configMeter{cfg: &got}
+	meter := bind.Meter(mock, userAlice)
+
+	cb := func(context.Context, metric.Int64Observer) error { return nil }
+	_, err := meter.Int64ObservableCounter(
+		"counter",
+		metric.WithDescription("desc"),
+		metric.WithUnit("1"),
+		metric.WithInt64Callback(cb),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "desc", got.Description())
+	assert.Equal(t, "1", got.Unit())
+	assert.Len(t, got.Callbacks(), 1)
+}
+
+type configMeter struct {
+	noop.Meter
+
+	cfg *metric.Int64ObservableCounterConfig
+}
+
+func (m *configMeter) Int64ObservableCounter(n string, o ...metric.Int64ObservableCounterOption) (metric.Int64ObservableCounter, error) {
+	*m.cfg = metric.NewInt64ObservableCounterConfig(o...)
+	return m.Meter.Int64ObservableCounter(n, o...)
+}
+
+func TestMeterRegisterCallback(t *testing.T) {
+	mock := &mockMeter{}
+	meter := bind.Meter(mock, userAlice)
+
+	iCntr, err := meter.Int64ObservableCounter("int64")
+	require.NoError(t, err)
+	fGauge, err := meter.Float64ObservableGauge("float64")
+	require.NoError(t, err)
+	unbound := &mockInt64ObservableGauge{name: "unbound"}
+
+	reg, err := meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(iCntr, 1)
+		o.ObserveFloat64(fGauge, 2, metric.WithAttributes(adminTrue))
+		o.ObserveInt64(unbound, 3)
+		return nil
+	}, iCntr, fGauge, unbound)
+	require.NoError(t, err)
+	require.NotNil(t, reg)
+
+	baseICntr, _ := bind.Unwrap(iCntr)
+	baseFGauge, _ := bind.Unwrap(fGauge)
+	assert.Equal(t, []metric.Observable{baseICntr, baseFGauge, unbound}, mock.insts, "underlying meter should be passed unbound instruments")
+
+	obs := &mockObserver{}
+	require.NoError(t, mock.callback(context.Background(), obs))
+
+	want := []observation{
+		{inst: baseICntr, value: 1, attrs: []attribute.KeyValue{userAlice}},
+		{inst: baseFGauge, value: 2, attrs: []attribute.KeyValue{adminTrue, userAlice}},
+		{inst: unbound, value: 3},
+	}
+	assert.Equal(t, want, obs.got)
+
+	require.NoError(t, reg.Unregister())
+	assert.True(t, mock.reg.unregistered, "Unregister should delegate")
+}
+
+func TestMeterRegisterCallbackError(t *testing.T) {
+	mock := &mockMeter{err: assert.AnError}
+	meter := bind.Meter(mock, userAlice)
+
+	reg, err := meter.RegisterCallback(func(context.Context, metric.Observer) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, reg)
+}
@@ -0,0 +1,170 @@
+package bind
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// pipeline determines the attributes of measurements made by a bound
+// instrument configured with options.
+//
+// Attributes are merged in the following order, with later attributes taking
+// precedence over earlier ones with the same key:
+//
+//  1. Bound attributes.
+//  2. Baggage attributes.
+//  3. Span attributes.
+//  4. Context attributes.
+//  5. Call-site attributes.
+//
+// The conflict policy of the config can give bound attributes precedence
+// instead.
+//
+// All attributes other than the bound attributes are filtered before being
+// merged with the bound attributes. The merged attributes are then subject to
+// any cardinality limit. If configured, the resulting measurement options are
+// cached by the filtered attributes they were merged from.
+type pipeline struct {
+	cfg     *config
+	limiter *limiter
+	cache   *cache
+}
+
+// configured is implemented by all bound synchronous instruments.
+type configured interface {
+	pipeline() *pipeline
+}
+
+// newPipeline returns a new pipeline for cfg. If cfg is nil, nil is returned.
+func newPipeline(cfg *config) *pipeline {
+	if cfg == nil {
+		return nil
+	}
+	p := &pipeline{cfg: cfg}
+	if cfg.limit != nil {
+		p.limiter = newLimiter(cfg.limit)
+	}
+	if cfg.cacheSize > 0 {
+		p.cache = newCache(cfg.cacheSize)
+	}
+	return p
+}
+
+// meterPipeline returns the pipeline of an instrument created by a Meter
+// configured with cfg. Options a Meter applies when creating instruments, like
+// instrument rules and defaults, do not need a pipeline. If cfg has no options
+// applied when measurements are made, nil is returned so measurements do not
+// allocate.
+func meterPipeline(cfg *config) *pipeline {
+	if cfg == nil || !cfg.measured() {
+		return nil
+	}
+	return newPipeline(cfg)
+}
+
+// config returns the config of p. If p is nil, nil is returned.
+func (p *pipeline) config() *config {
+	if p == nil {
+		return nil
+	}
+	return p.cfg
+}
+
+// fork returns a new pipeline with the same configuration and limiter as p.
+// The cache is not shared as it caches options that include bound
+// attributes.
+func (p *pipeline) fork() *pipeline {
+	if p == nil {
+		return nil
+	}
+	f := &pipeline{cfg: p.cfg, limiter: p.limiter}
+	if p.cfg.cacheSize > 0 {
+		f.cache = newCache(p.cfg.cacheSize)
+	}
+	return f
+}
+
+// addOptions returns the options for an Add measurement made with ctx by an
+// instrument bound to bound and passed the call-site attributes.
+func (p *pipeline) addOptions(ctx context.Context, bound, callSite attribute.Set) []metric.AddOption {
+	o, e := p.option(ctx, bound, callSite)
+	if e != nil {
+		return e.add
+	}
+	return []metric.AddOption{o}
+}
+
+// recordOptions returns the options for a Record measurement made with ctx by
+// an instrument bound to bound and passed the call-site attributes.
+func (p *pipeline) recordOptions(ctx context.Context, bound, callSite attribute.Set) []metric.RecordOption {
+	o, e := p.option(ctx, bound, callSite)
+	if e != nil {
+		return e.rec
+	}
+	return []metric.RecordOption{o}
+}
+
+// option returns the measurement option for a measurement made with ctx by an
+// instrument bound to bound and passed the call-site attributes. If the
+// measurement option is cached, the cache entry is returned instead.
+func (p *pipeline) option(ctx context.Context, bound, callSite attribute.Set) (metric.MeasurementOption, *cacheEntry) {
+	dynamic := *attribute.EmptySet()
+	if len(p.cfg.baggage) > 0 {
+		dynamic = baggageAttributes(ctx, p.cfg.baggage)
+	}
+	if p.cfg.span != nil {
+		dynamic = merge(dynamic, spanAttributes(ctx, p.cfg.span))
+	}
+	if p.cfg.ctxAttrs {
+		dynamic = merge(dynamic, AttributesFromContext(ctx))
+	}
+	dynamic = merge(dynamic, callSite)
+	if p.cfg.filter != nil {
+		dynamic, _ = dynamic.Filter(p.cfg.filter)
+	}
+
+	key := dynamic.Equivalent()
+	if p.cache != nil {
+		if e, ok := p.cache.get(key); ok {
+			return nil, e
+		}
+	}
+
+	set, cacheable := p.cfg.resolve(bound, dynamic), true
+	if p.limiter != nil {
+		// Measurements redirected to the overflow set are not cached so they
+		// continue to be counted.
+		set, cacheable = p.limiter.limit(bound, set)
+	}
+
+	o := metric.WithAttributeSet(set)
+	if p.cache != nil && cacheable {
+		return nil, p.cache.put(key, o)
+	}
+	return o, nil
+}
+
+// merge returns the union of a and b. Any duplicate keys will use the value
+// from b.
+func merge(a, b attribute.Set) attribute.Set {
+	switch {
+	case a.Len() == 0:
+		return b
+	case b.Len() == 0:
+		return a
+	}
+	// Merge in a separate function so a and b do not escape when empty.
+	return mergeSets(a, b)
+}
+
+func mergeSets(a, b attribute.Set) attribute.Set {
+	// NewMergeIterator uses the first value for any duplicates.
+	iter := attribute.NewMergeIterator(&b, &a)
+	merged := make([]attribute.KeyValue, 0, a.Len()+b.Len())
+	for iter.Next() {
+		merged = append(merged, iter.Attribute())
+	}
+	return attribute.NewSet(merged...)
+}
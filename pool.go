@@ -23,3 +23,12 @@ var recordOptPool = &sync.Pool{
 		return &s
 	},
 }
+
+var observeOptPool = &sync.Pool{
+	New: func() any {
+		// This pool is used for ObserveOption slices for observations made
+		// with bound asynchronous instruments.
+		s := make([]metric.ObserveOption, 0, 2)
+		return &s
+	},
+}
@@ -0,0 +1,145 @@
+package bind
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// InstrumentKind identifies a kind of instrument a [metric.Meter] creates.
+// Kinds can be combined to match multiple kinds, e.g.
+// KindInt64Histogram|KindFloat64Histogram matches all histograms.
+type InstrumentKind uint16
+
+// Instrument kinds.
+const (
+	KindInt64Counter InstrumentKind = 1 << iota
+	KindInt64UpDownCounter
+	KindInt64Histogram
+	KindInt64Gauge
+	KindFloat64Counter
+	KindFloat64UpDownCounter
+	KindFloat64Histogram
+	KindFloat64Gauge
+	KindInt64ObservableCounter
+	KindInt64ObservableUpDownCounter
+	KindInt64ObservableGauge
+	KindFloat64ObservableCounter
+	KindFloat64ObservableUpDownCounter
+	KindFloat64ObservableGauge
+
+	// KindAll matches all instrument kinds.
+	KindAll InstrumentKind = 1<<iota - 1
+)
+
+var kindNames = [...]string{
+	"Int64Counter",
+	"Int64UpDownCounter",
+	"Int64Histogram",
+	"Int64Gauge",
+	"Float64Counter",
+	"Float64UpDownCounter",
+	"Float64Histogram",
+	"Float64Gauge",
+	"Int64ObservableCounter",
+	"Int64ObservableUpDownCounter",
+	"Int64ObservableGauge",
+	"Float64ObservableCounter",
+	"Float64ObservableUpDownCounter",
+	"Float64ObservableGauge",
+}
+
+// String returns the names of the kinds k matches joined by "|".
+func (k InstrumentKind) String() string {
+	var names []string
+	for i, name := range kindNames {
+		if k&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "InstrumentKind(0)"
+	}
+	return strings.Join(names, "|")
+}
+
+// instrumentRule applies to instruments created by a Meter that match both
+// its name pattern and kinds.
+type instrumentRule struct {
+	// glob is the name pattern. If nil, all names match.
+	glob *glob
+	// kinds are the matching instrument kinds.
+	kinds InstrumentKind
+	// attrs are bound to matching instruments.
+	attrs []attribute.KeyValue
+	// unbound is true if matching instruments are not bound.
+	unbound bool
+	// defaults are applied to matching instruments. If nil, no defaults are
+	// applied.
+	defaults *InstrumentDefaults
+}
+
+func (r instrumentRule) match(name string, kind InstrumentKind) bool {
+	return r.kinds&kind != 0 && (r.glob == nil || r.glob.match(name))
+}
+
+// withRule returns an [Option] that adds r to the instrument rules.
+func withRule(r instrumentRule) Option {
+	return optionFunc(func(c config) config {
+		rules := make([]instrumentRule, 0, len(c.rules)+1)
+		rules = append(rules, c.rules...)
+		c.rules = append(rules, r)
+		return c
+	})
+}
+
+// WithInstrumentAttributes returns an [Option] that binds attrs, in addition
+// to the Meter's bound attributes, to all instruments a [metric.Meter]
+// creates with a name matching pattern. The pattern is matched against the
+// complete instrument name. The wildcard '*' matches any sequence of
+// characters, all other characters match themselves.
+//
+// This option only applies to a Meter (see [Meter] and [Configure]).
+// Attributes of later rules take precedence over earlier ones and over the
+// Meter's bound attributes, subject to the conflict policy (see
+// [WithConflictPolicy]).
+func WithInstrumentAttributes(pattern string, attrs ...attribute.KeyValue) Option {
+	g := newGlob(pattern)
+	return withRule(instrumentRule{glob: &g, kinds: KindAll, attrs: cloneAttrs(attrs)})
+}
+
+// WithKindAttributes returns an [Option] that binds attrs, in addition to the
+// Meter's bound attributes, to all instruments a [metric.Meter] creates of
+// kind. See [WithInstrumentAttributes] for how the attributes are applied.
+func WithKindAttributes(kind InstrumentKind, attrs ...attribute.KeyValue) Option {
+	return withRule(instrumentRule{kinds: kind, attrs: cloneAttrs(attrs)})
+}
+
+// WithUnboundInstruments returns an [Option] that leaves all instruments a
+// [metric.Meter] creates with a name matching any of patterns unbound. These
+// instruments are returned from the underlying Meter as is, without any bound
+// attributes or measurement options. See [WithInstrumentAttributes] for the
+// pattern syntax.
+//
+// This option only applies to a Meter (see [Meter] and [Configure]). It takes
+// precedence over all other instrument rules.
+func WithUnboundInstruments(patterns ...string) Option {
+	opts := make([]Option, len(patterns))
+	for i, p := range patterns {
+		g := newGlob(p)
+		opts[i] = withRule(instrumentRule{glob: &g, kinds: KindAll, unbound: true})
+	}
+	return optionFunc(func(c config) config {
+		for _, o := range opts {
+			c = o.apply(c)
+		}
+		return c
+	})
+}
+
+func cloneAttrs(attrs []attribute.KeyValue) []attribute.KeyValue {
+	// NewSet sorts passed attributes. Copy to avoid side effect.
+	cp := make([]attribute.KeyValue, len(attrs))
+	copy(cp, attrs)
+	return cp
+}
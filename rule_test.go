@@ -0,0 +1,148 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+var cacheUsers = attribute.String("cache.name", "users")
+
+func instAttrs[T any](t *testing.T, inst T, err error) []attribute.KeyValue {
+	t.Helper()
+
+	require.NoError(t, err)
+	_, set := bind.Unwrap(inst)
+	return set.ToSlice()
+}
+
+func TestWithInstrumentAttributes(t *testing.T) {
+	meter := bind.Configure(
+		bind.Meter(&mockMeter{}, userAlice),
+		bind.WithInstrumentAttributes("cache.*", cacheUsers),
+		bind.WithInstrumentAttributes("cache.hits", userID),
+	)
+
+	hits, err := meter.Int64Counter("cache.hits")
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, cacheUsers, userID}, instAttrs(t, hits, err))
+
+	size, err := meter.Int64ObservableGauge("cache.size")
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, cacheUsers}, instAttrs(t, size, err))
+
+	reqs, err := meter.Float64Counter("http.requests")
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice}, instAttrs(t, reqs, err))
+}
+
+func TestWithInstrumentAttributesPrecedence(t *testing.T) {
+	meter := bind.Configure(
+		bind.Meter(&mockMeter{}, userAlice),
+		bind.WithInstrumentAttributes("*", attribute.String("user", "bob")),
+		bind.WithInstrumentAttributes("requests", attribute.String("user", "carol")),
+	)
+
+	inst, err := meter.Int64Counter("requests")
+	assert.Equal(t, []attribute.KeyValue{attribute.String("user", "carol")}, instAttrs(t, inst, err))
+}
+
+func TestWithKindAttributes(t *testing.T) {
+	histograms := bind.KindInt64Histogram | bind.KindFloat64Histogram
+	meter := bind.Configure[metric.Meter](&mockMeter{}, bind.WithKindAttributes(histograms, adminTrue))
+
+	iHist, err := meter.Int64Histogram("int64")
+	assert.Equal(t, []attribute.KeyValue{adminTrue}, instAttrs(t, iHist, err))
+
+	fHist, err := meter.Float64Histogram("float64")
+	assert.Equal(t, []attribute.KeyValue{adminTrue}, instAttrs(t, fHist, err))
+
+	cntr, err := meter.Float64Counter("counter")
+	assert.Empty(t, instAttrs(t, cntr, err))
+}
+
+func TestWithUnboundInstruments(t *testing.T) {
+	mock := &mockMeter{}
+	meter := bind.Configure(
+		bind.Meter(mock, userAlice),
+		bind.WithInstrumentAttributes("*", adminTrue),
+		bind.WithUnboundInstruments("runtime.*", "process.cpu"),
+	)
+
+	cntr, err := meter.Int64Counter("runtime.gc")
+	require.NoError(t, err)
+	assert.IsType(t, &mockInt64Counter{}, cntr, "unbound instrument")
+
+	gauge, err := meter.Float64ObservableGauge("process.cpu")
+	require.NoError(t, err)
+	assert.IsType(t, &mockFloat64ObservableGauge{}, gauge, "unbound asynchronous instrument")
+
+	hist, err := meter.Float64Histogram("http.duration")
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, adminTrue}, instAttrs(t, hist, err))
+}
+
+func TestInstrumentRulesError(t *testing.T) {
+	mock := &mockMeter{err: assert.AnError}
+	meter := bind.Configure(
+		bind.Meter(mock, userAlice),
+		bind.WithInstrumentAttributes("bound", adminTrue),
+		bind.WithUnboundInstruments("unbound"),
+	)
+
+	bound, err := meter.Int64Counter("bound")
+	require.ErrorIs(t, err, assert.AnError)
+	assert.NotNil(t, bound, "instrument returned with error should be bound")
+
+	unbound, err := meter.Int64Counter("unbound")
+	require.ErrorIs(t, err, assert.AnError)
+	assert.IsType(t, &mockInt64Counter{}, unbound)
+}
+
+func TestInstrumentRulesMeterProvider(t *testing.T) {
+	mp := bind.Configure(
+		bind.MeterProvider(&mockMeterProvider{}, userAlice),
+		bind.WithInstrumentAttributes("cache.*", cacheUsers),
+	)
+
+	inst, err := mp.Meter("scope").Int64Counter("cache.hits")
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, cacheUsers}, instAttrs(t, inst, err))
+}
+
+func TestInstrumentRulesAllocs(t *testing.T) {
+	meter := bind.Configure(
+		bind.Meter(noop.Meter{}, userAlice),
+		bind.WithInstrumentAttributes("cache.*", cacheUsers),
+	)
+	inst, err := meter.Int64Counter("cache.hits")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	allocs := testing.AllocsPerRun(100, func() { inst.Add(ctx, 1) })
+	assert.Zero(t, allocs, "instrument rules should not allocate per measurement")
+}
+
+func BenchmarkInstrumentRules(b *testing.B) {
+	ctx := context.Background()
+	meter := bind.Configure(
+		bind.Meter(noop.Meter{}, userAlice),
+		bind.WithInstrumentAttributes("cache.*", cacheUsers),
+	)
+	inst, err := meter.Int64Counter("cache.hits")
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			inst.Add(ctx, 1)
+		}
+	})
+}
+
+func TestInstrumentKindString(t *testing.T) {
+	assert.Equal(t, "Int64Counter", bind.KindInt64Counter.String())
+	assert.Equal(t, "Int64Histogram|Float64Histogram", (bind.KindInt64Histogram | bind.KindFloat64Histogram).String())
+	assert.Equal(t, "InstrumentKind(0)", bind.InstrumentKind(0).String())
+}
@@ -0,0 +1,107 @@
+package bind
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanOption configures how attributes of the active span are included in
+// measurements.
+type SpanOption interface {
+	applySpan(spanConfig) spanConfig
+}
+
+type spanOptionFunc func(spanConfig) spanConfig
+
+func (f spanOptionFunc) applySpan(c spanConfig) spanConfig { return f(c) }
+
+// spanConfig defines the active span attributes included in measurements.
+type spanConfig struct {
+	// keys is the allowlist of span attribute keys.
+	keys map[attribute.Key]struct{}
+	// sampled is the attribute key the sampled flag is included with. If
+	// empty, the flag is not included.
+	sampled attribute.Key
+}
+
+// WithSpanSampled returns a [SpanOption] that includes whether the active span
+// is sampled as a bool attribute with key. The attribute is only included if
+// the measurement context contains a valid span context.
+func WithSpanSampled(key attribute.Key) SpanOption {
+	return spanOptionFunc(func(c spanConfig) spanConfig {
+		c.sampled = key
+		return c
+	})
+}
+
+// attributesSpan is implemented by spans that expose their attributes, e.g.
+// the ReadOnlySpan of the OpenTelemetry SDK.
+type attributesSpan interface {
+	Attributes() []attribute.KeyValue
+}
+
+// WithSpanAttributes returns an [Option] that includes the attributes of the
+// active span in the measurement context with keys in the keys allowlist in
+// all measurements. Span attributes not in keys are ignored.
+//
+// Span attributes are only available if the span implementation exposes them
+// with an Attributes() []attribute.KeyValue method, like the spans of the
+// OpenTelemetry SDK do. Reading span attributes copies all of them for every
+// measurement. Use [WithCache] to avoid merging the same attributes
+// repeatedly.
+//
+// Span attributes take precedence over bound and baggage attributes with the
+// same key. Context attributes (see [WithContextAttributes]) and attributes
+// passed when making a measurement take precedence over span attributes.
+//
+// Multiple WithSpanAttributes options can be used. The keys of all options
+// are included.
+func WithSpanAttributes(keys []attribute.Key, opts ...SpanOption) Option {
+	var sc spanConfig
+	for _, o := range opts {
+		sc = o.applySpan(sc)
+	}
+
+	return optionFunc(func(c config) config {
+		span := spanConfig{keys: make(map[attribute.Key]struct{}, len(keys))}
+		if c.span != nil {
+			span.sampled = c.span.sampled
+			for k := range c.span.keys {
+				span.keys[k] = struct{}{}
+			}
+		}
+		for _, k := range keys {
+			span.keys[k] = struct{}{}
+		}
+		if sc.sampled != "" {
+			span.sampled = sc.sampled
+		}
+		c.span = &span
+		return c
+	})
+}
+
+// spanAttributes returns the attributes of the active span in ctx defined by
+// sc.
+func spanAttributes(ctx context.Context, sc *spanConfig) attribute.Set {
+	span := trace.SpanFromContext(ctx)
+
+	var attrs []attribute.KeyValue
+	if len(sc.keys) > 0 {
+		if s, ok := span.(attributesSpan); ok {
+			for _, kv := range s.Attributes() {
+				if _, ok := sc.keys[kv.Key]; ok {
+					attrs = append(attrs, kv)
+				}
+			}
+		}
+	}
+	if sc.sampled != "" {
+		if sCtx := span.SpanContext(); sCtx.IsValid() {
+			attrs = append(attrs, sc.sampled.Bool(sCtx.IsSampled()))
+		}
+	}
+	return attribute.NewSet(attrs...)
+}
@@ -0,0 +1,128 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// attrSpan is a span that exposes its attributes like the SDK ReadOnlySpan.
+type attrSpan struct {
+	noop.Span
+
+	sc    trace.SpanContext
+	attrs []attribute.KeyValue
+}
+
+func (s attrSpan) SpanContext() trace.SpanContext   { return s.sc }
+func (s attrSpan) Attributes() []attribute.KeyValue { return s.attrs }
+
+func contextWithSpan(sampled bool, attrs ...attribute.KeyValue) context.Context {
+	cfg := trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	}
+	if sampled {
+		cfg.TraceFlags = trace.FlagsSampled
+	}
+	span := attrSpan{sc: trace.NewSpanContext(cfg), attrs: attrs}
+	return trace.ContextWithSpan(context.Background(), span)
+}
+
+var routeAttr = attribute.String("http.route", "/users")
+
+func testSpanAttributes[T any, N any](mock Mock[T, N], b Binder[T], m Measure[T, N], val N) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		inst := bind.Configure(
+			b(mock.Instrument(), userAlice),
+			bind.WithSpanAttributes([]attribute.Key{"http.route", "user"}),
+		)
+		ctx := contextWithSpan(true, routeAttr, attribute.String("secret", "value"))
+
+		m(inst, ctx, val, nil)
+		_, got := mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, routeAttr}, got, "span attributes")
+
+		m(inst, context.Background(), val, nil)
+		_, got = mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice}, got, "no span")
+	}
+}
+
+func TestWithSpanAttributes(t *testing.T) {
+	t.Run("Int64Counter", testSpanAttributes(&mockInt64Counter{}, bind.Int64Counter, measInt64Counter, 1))
+	t.Run("Int64UpDownCounter", testSpanAttributes(&mockInt64UpDownCounter{}, bind.Int64UpDownCounter, measInt64UpDownCounter, 1))
+	t.Run("Int64Histogram", testSpanAttributes(&mockInt64Histogram{}, bind.Int64Histogram, measInt64Histogram, 1))
+	t.Run("Int64Gauge", testSpanAttributes(&mockInt64Gauge{}, bind.Int64Gauge, measInt64Gauge, 1))
+	t.Run("Float64Counter", testSpanAttributes(&mockFloat64Counter{}, bind.Float64Counter, measFloat64Counter, 1))
+	t.Run("Float64UpDownCounter", testSpanAttributes(&mockFloat64UpDownCounter{}, bind.Float64UpDownCounter, measFloat64UpDownCounter, 1))
+	t.Run("Float64Histogram", testSpanAttributes(&mockFloat64Histogram{}, bind.Float64Histogram, measFloat64Histogram, 1))
+	t.Run("Float64Gauge", testSpanAttributes(&mockFloat64Gauge{}, bind.Float64Gauge, measFloat64Gauge, 1))
+}
+
+func TestWithSpanSampled(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	inst := bind.Configure[metric.Float64Counter](
+		mock,
+		bind.WithSpanAttributes([]attribute.Key{"http.route"}),
+		bind.WithSpanAttributes(nil, bind.WithSpanSampled("sampled")),
+	)
+
+	inst.Add(contextWithSpan(true, routeAttr), 1)
+	_, got := mock.Recorded()
+	assert.ElementsMatch(t, []attribute.KeyValue{routeAttr, attribute.Bool("sampled", true)}, got, "sampled")
+
+	inst.Add(contextWithSpan(false), 1)
+	_, got = mock.Recorded()
+	assert.ElementsMatch(t, []attribute.KeyValue{attribute.Bool("sampled", false)}, got, "not sampled")
+
+	inst.Add(context.Background(), 1)
+	_, got = mock.Recorded()
+	assert.Empty(t, got, "invalid span context")
+}
+
+func TestWithSpanAttributesOpaqueSpan(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	inst := bind.Configure[metric.Float64Counter](
+		mock,
+		bind.WithSpanAttributes([]attribute.Key{"http.route"}, bind.WithSpanSampled("sampled")),
+	)
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	inst.Add(trace.ContextWithSpanContext(context.Background(), sc), 1)
+	_, got := mock.Recorded()
+	assert.Equal(t, []attribute.KeyValue{attribute.Bool("sampled", true)}, got)
+}
+
+func TestWithSpanAttributesPrecedence(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	inst := bind.Configure(
+		bind.Float64Counter(mock, userAlice, userID),
+		bind.WithBaggage([]string{"user", "id"}),
+		bind.WithSpanAttributes([]attribute.Key{"user", "id"}),
+		bind.WithContextAttributes(),
+	)
+
+	ctx := contextWithSpan(true, attribute.String("user", "dave"), attribute.String("id", "3"))
+	ctx = bind.ContextWithAttributes(ctx, attribute.String("id", "2"))
+	inst.Add(ctx, 1)
+
+	_, got := mock.Recorded()
+	want := []attribute.KeyValue{
+		attribute.String("id", "2"),
+		attribute.String("user", "dave"),
+	}
+	assert.ElementsMatch(t, want, got)
+}
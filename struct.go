@@ -0,0 +1,228 @@
+package bind
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// structTag is the struct field tag used to define attributes.
+const structTag = "otel"
+
+// Struct binds the attributes defined by the struct v (see [StructAttributes])
+// to inst. It dispatches to the bind function of the type T the same way
+// [Bind] does, and panics for the same unsupported types.
+func Struct[T any](inst T, v any) T {
+	return Bind(inst, StructAttributes(v)...)
+}
+
+// StructAttributes returns the attributes defined by the fields of the struct
+// v, or the struct v points to, that have an "otel" tag. The tag value is the
+// attribute key, optionally followed by ",omitempty" to omit the attribute
+// when the field has the zero value. Fields with a "-" tag are ignored.
+//
+//	type Route struct {
+//		Method string `otel:"http.request.method"`
+//		Path   string `otel:"http.route"`
+//		Tenant string `otel:"tenant,omitempty"`
+//	}
+//
+// Fields of embedded structs without a tag are included as if they were
+// fields of v. Supported field types are strings, signed and unsigned
+// integers, bools, floats, slices of these types, and types implementing
+// [fmt.Stringer]. Pointer fields are dereferenced and omitted when nil.
+//
+// StructAttributes panics if v is not a struct or pointer to a struct, or if
+// a tagged field has an unsupported type. A nil pointer returns no
+// attributes. How attributes are derived from a struct type is computed once
+// and cached.
+func StructAttributes(v any) []attribute.KeyValue {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("bind: StructAttributes of non-struct type %T", v))
+	}
+
+	p := planFor(rv.Type())
+	attrs := make([]attribute.KeyValue, 0, len(p))
+	for _, f := range p {
+		if kv, ok := f.attribute(rv); ok {
+			attrs = append(attrs, kv)
+		}
+	}
+	return attrs
+}
+
+// plans caches the structPlan of struct types.
+var plans sync.Map // map[reflect.Type]structPlan
+
+// structPlan is the list of fields of a struct type that define attributes.
+type structPlan []fieldPlan
+
+// planFor returns the cached structPlan of the struct type t.
+func planFor(t reflect.Type) structPlan {
+	if p, ok := plans.Load(t); ok {
+		return p.(structPlan)
+	}
+	p, _ := plans.LoadOrStore(t, newStructPlan(t, nil))
+	return p.(structPlan)
+}
+
+// newStructPlan returns the structPlan of the struct type t. The index of
+// each field is prefixed with index.
+func newStructPlan(t reflect.Type, index []int) structPlan {
+	var p structPlan
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		idx := append(index[:len(index):len(index)], i)
+
+		tag, tagged := sf.Tag.Lookup(structTag)
+		if !tagged {
+			if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+				p = append(p, newStructPlan(sf.Type, idx)...)
+			}
+			continue
+		}
+		if tag == "-" || !sf.IsExported() {
+			continue
+		}
+
+		key, opt, _ := strings.Cut(tag, ",")
+		if key == "" {
+			key = sf.Name
+		}
+		conv := converter(sf.Type)
+		if conv == nil {
+			panic(fmt.Sprintf("bind: unsupported type %s for field %s.%s", sf.Type, t, sf.Name))
+		}
+		p = append(p, fieldPlan{
+			index:     idx,
+			key:       attribute.Key(key),
+			omitEmpty: opt == "omitempty",
+			conv:      conv,
+		})
+	}
+	return p
+}
+
+// fieldPlan defines how a struct field is converted to an attribute.
+type fieldPlan struct {
+	index     []int
+	key       attribute.Key
+	omitEmpty bool
+	conv      func(reflect.Value) attribute.Value
+}
+
+// attribute returns the attribute defined by the field of the struct v. It
+// returns false if the attribute is omitted.
+func (f fieldPlan) attribute(v reflect.Value) (attribute.KeyValue, bool) {
+	fv := v.FieldByIndex(f.index)
+	if f.omitEmpty && fv.IsZero() {
+		return attribute.KeyValue{}, false
+	}
+	if k := fv.Kind(); (k == reflect.Pointer || k == reflect.Interface) && fv.IsNil() {
+		return attribute.KeyValue{}, false
+	}
+	return attribute.KeyValue{Key: f.key, Value: f.conv(fv)}, true
+}
+
+var stringerType = reflect.TypeFor[fmt.Stringer]()
+
+// valueType returns the attribute type a value of type t is converted to. It
+// returns [attribute.INVALID] if t is not supported.
+func valueType(t reflect.Type) attribute.Type {
+	if t.Implements(stringerType) {
+		return attribute.STRING
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return attribute.STRING
+	case reflect.Bool:
+		return attribute.BOOL
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return attribute.INT64
+	case reflect.Float32, reflect.Float64:
+		return attribute.FLOAT64
+	default:
+		return attribute.INVALID
+	}
+}
+
+// converter returns a function that converts a value of type t to an
+// attribute value. It returns nil if t is not supported.
+func converter(t reflect.Type) func(reflect.Value) attribute.Value {
+	switch valueType(t) {
+	case attribute.STRING:
+		if t.Implements(stringerType) {
+			return func(v reflect.Value) attribute.Value {
+				return attribute.StringValue(v.Interface().(fmt.Stringer).String())
+			}
+		}
+		return func(v reflect.Value) attribute.Value { return attribute.StringValue(v.String()) }
+	case attribute.BOOL:
+		return func(v reflect.Value) attribute.Value { return attribute.BoolValue(v.Bool()) }
+	case attribute.INT64:
+		if t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uintptr {
+			return func(v reflect.Value) attribute.Value { return attribute.Int64Value(int64(v.Uint())) } //nolint:gosec // Overflow wraps.
+		}
+		return func(v reflect.Value) attribute.Value { return attribute.Int64Value(v.Int()) }
+	case attribute.FLOAT64:
+		return func(v reflect.Value) attribute.Value { return attribute.Float64Value(v.Float()) }
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		if conv := converter(t.Elem()); conv != nil {
+			return func(v reflect.Value) attribute.Value { return conv(v.Elem()) }
+		}
+	case reflect.Slice, reflect.Array:
+		return sliceConverter(t.Elem())
+	}
+	return nil
+}
+
+// sliceConverter returns a function that converts a slice or array with
+// elements of type elem to an attribute value. It returns nil if elem is not
+// supported.
+func sliceConverter(elem reflect.Type) func(reflect.Value) attribute.Value {
+	if k := elem.Kind(); k == reflect.Pointer || k == reflect.Interface {
+		// Nil elements cannot be converted.
+		return nil
+	}
+
+	conv := converter(elem)
+	switch valueType(elem) {
+	case attribute.STRING:
+		return sliceOf(conv, attribute.Value.AsString, attribute.StringSliceValue)
+	case attribute.BOOL:
+		return sliceOf(conv, attribute.Value.AsBool, attribute.BoolSliceValue)
+	case attribute.INT64:
+		return sliceOf(conv, attribute.Value.AsInt64, attribute.Int64SliceValue)
+	case attribute.FLOAT64:
+		return sliceOf(conv, attribute.Value.AsFloat64, attribute.Float64SliceValue)
+	default:
+		return nil
+	}
+}
+
+// sliceOf returns a function that converts a slice or array to an attribute
+// value using conv to convert each element.
+func sliceOf[E any](conv func(reflect.Value) attribute.Value, as func(attribute.Value) E, value func([]E) attribute.Value) func(reflect.Value) attribute.Value {
+	return func(v reflect.Value) attribute.Value {
+		s := make([]E, v.Len())
+		for i := range s {
+			s[i] = as(conv(v.Index(i)))
+		}
+		return value(s)
+	}
+}
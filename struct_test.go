@@ -0,0 +1,119 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type route struct {
+	Method string `otel:"http.request.method"`
+	Path   string `otel:"http.route"`
+}
+
+type level int
+
+func (l level) String() string { return [...]string{"low", "high"}[l] }
+
+type request struct {
+	route
+
+	Tenant   string        `otel:"tenant,omitempty"`
+	Status   int           `otel:"status"`
+	Size     uint32        `otel:"size"`
+	Cached   bool          `otel:"cached"`
+	Ratio    float32       `otel:"ratio"`
+	Tags     []string      `otel:"tags"`
+	Codes    [2]int        `otel:"codes"`
+	Levels   []level       `otel:"levels"`
+	Level    level         `otel:"level"`
+	Timeout  time.Duration `otel:"timeout"`
+	Retry    *int          `otel:"retry"`
+	Attempt  *int          `otel:"attempt"`
+	Ignored  string        `otel:"-"`
+	Untagged string
+	private  string `otel:"private"` //nolint:unused // Testing unexported fields are ignored.
+}
+
+func TestStructAttributes(t *testing.T) {
+	attempt := 2
+	req := request{
+		route:   route{Method: "GET", Path: "/users"},
+		Status:  200,
+		Size:    64,
+		Cached:  true,
+		Ratio:   0.5,
+		Tags:    []string{"a", "b"},
+		Codes:   [2]int{1, 2},
+		Levels:  []level{0, 1},
+		Level:   1,
+		Timeout: time.Second,
+		Attempt: &attempt,
+		Ignored: "ignored",
+	}
+
+	want := []attribute.KeyValue{
+		attribute.String("http.request.method", "GET"),
+		attribute.String("http.route", "/users"),
+		attribute.Int("status", 200),
+		attribute.Int("size", 64),
+		attribute.Bool("cached", true),
+		attribute.Float64("ratio", 0.5),
+		attribute.StringSlice("tags", []string{"a", "b"}),
+		attribute.IntSlice("codes", []int{1, 2}),
+		attribute.StringSlice("levels", []string{"low", "high"}),
+		attribute.String("level", "high"),
+		attribute.String("timeout", "1s"),
+		attribute.Int("attempt", 2),
+	}
+	assert.Equal(t, want, bind.StructAttributes(req))
+	assert.Equal(t, want, bind.StructAttributes(&req), "pointer to struct")
+
+	req.Tenant = "acme"
+	assert.Contains(t, bind.StructAttributes(req), attribute.String("tenant", "acme"))
+}
+
+func TestStructAttributesNil(t *testing.T) {
+	assert.Empty(t, bind.StructAttributes((*route)(nil)))
+}
+
+func TestStructAttributesPanics(t *testing.T) {
+	assert.Panics(t, func() { bind.StructAttributes("route") }, "non-struct")
+
+	type unsupported struct {
+		Map map[string]string `otel:"map"`
+	}
+	assert.Panics(t, func() { bind.StructAttributes(unsupported{}) }, "unsupported field")
+}
+
+func TestStruct(t *testing.T) {
+	r := route{Method: "GET", Path: "/users"}
+	want := []attribute.KeyValue{
+		attribute.String("http.request.method", "GET"),
+		attribute.String("http.route", "/users"),
+	}
+
+	mock := &mockFloat64Counter{}
+	counter := bind.Struct[metric.Float64Counter](mock, r)
+	counter.Add(context.Background(), 1, metric.WithAttributes(userID))
+	_, got := mock.Recorded()
+	assert.ElementsMatch(t, append(want, userID), got)
+
+	meter := bind.Struct[metric.Meter](&mockMeter{}, &r)
+	_, set := bind.Unwrap(meter)
+	assert.ElementsMatch(t, want, set.ToSlice())
+}
+
+func BenchmarkStructAttributes(b *testing.B) {
+	r := route{Method: "GET", Path: "/users"}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = bind.StructAttributes(r)
+	}
+}
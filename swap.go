@@ -0,0 +1,184 @@
+package bind
+
+import (
+	"reflect"
+	"slices"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// SwappableAttributes is a set of attributes that can be replaced at runtime.
+// All instruments bound to it using [Swappable] include its current
+// attributes in their measurements.
+//
+// SwappableAttributes is safe for concurrent use. Replacing the attributes
+// while measurements are being made is safe, and each measurement includes
+// either the previous or the new attributes.
+type SwappableAttributes struct {
+	state atomic.Pointer[swapState]
+}
+
+// swapState is an immutable attribute set of a SwappableAttributes and its
+// precomputed measurement options.
+type swapState struct {
+	attrs []attribute.KeyValue
+	set   attribute.Set
+	add   []metric.AddOption
+	rec   []metric.RecordOption
+	obs   []metric.ObserveOption
+}
+
+func newSwapState(attrs []attribute.KeyValue) *swapState {
+	// NewSet sorts passed attributes. Copy to avoid side effect.
+	cp := slices.Clone(attrs)
+	set := attribute.NewSet(cp...)
+	o := metric.WithAttributeSet(set)
+	return &swapState{
+		attrs: cp,
+		set:   set,
+		add:   []metric.AddOption{o},
+		rec:   []metric.RecordOption{o},
+		obs:   []metric.ObserveOption{o},
+	}
+}
+
+// swapObservable holds the state of an asynchronous instrument created by a
+// swappable Meter.
+type swapObservable struct {
+	s *SwappableAttributes
+
+	// merged is true if the instrument is bound to bound by the underlying
+	// Meter. The options of those attributes are replaced by ones of bound
+	// merged with the swappable attributes.
+	merged bool
+	bound  attribute.Set
+
+	// opts are the merged options of the last state of s observed.
+	opts atomic.Pointer[swapObserveOptions]
+}
+
+// swapObserveOptions are the observe options of a swapObservable for a state
+// of its SwappableAttributes.
+type swapObserveOptions struct {
+	state *swapState
+	obs   []metric.ObserveOption
+}
+
+// options returns the options observations of o need to include for the
+// current state of its SwappableAttributes. The options are only computed
+// once per state.
+func (o *swapObservable) options() []metric.ObserveOption {
+	st := o.s.load()
+	if o.bound.Len() == 0 {
+		return st.obs
+	}
+	if c := o.opts.Load(); c != nil && c.state == st {
+		return c.obs
+	}
+
+	set := merge(o.bound, st.set)
+	c := &swapObserveOptions{
+		state: st,
+		obs:   []metric.ObserveOption{metric.WithAttributeSet(set)},
+	}
+	o.opts.Store(c)
+	return c.obs
+}
+
+// NewSwappableAttributes returns a new [SwappableAttributes] containing
+// attrs.
+func NewSwappableAttributes(attrs ...attribute.KeyValue) *SwappableAttributes {
+	s := new(SwappableAttributes)
+	s.state.Store(newSwapState(attrs))
+	return s
+}
+
+// load returns the current state of s.
+func (s *SwappableAttributes) load() *swapState {
+	return s.state.Load()
+}
+
+// Attributes returns the current attributes of s.
+func (s *SwappableAttributes) Attributes() attribute.Set {
+	return s.load().set
+}
+
+// Set replaces the attributes of s with attrs.
+func (s *SwappableAttributes) Set(attrs ...attribute.KeyValue) {
+	s.state.Store(newSwapState(attrs))
+}
+
+// Update replaces the attributes of s with the attributes returned by f. The
+// current attributes are passed to f as a copy that f can modify.
+//
+// Update is atomic with respect to other calls to Set and Update. If the
+// attributes are replaced concurrently, f is called again with the newly
+// replaced attributes.
+func (s *SwappableAttributes) Update(f func([]attribute.KeyValue) []attribute.KeyValue) {
+	for {
+		prev := s.load()
+		next := newSwapState(f(slices.Clone(prev.attrs)))
+		if s.state.CompareAndSwap(prev, next) {
+			return
+		}
+	}
+}
+
+// Swappable binds s to inst. All measurements made with the returned
+// instrument include the current attributes of s, taking precedence over any
+// attributes inst is bound to with the same key. Attributes passed when making
+// a measurement take precedence over the attributes of s.
+//
+// If inst is configured with options (see [Configure]), the attributes of s
+// are treated as attributes passed when making a measurement.
+//
+// Binding a [metric.Meter] or [metric.MeterProvider] binds s to all
+// instruments they create, including asynchronous instruments.
+//
+// If s is nil, inst is returned unchanged.
+//
+// T needs to be one of the following types, otherwise Swappable panics:
+//
+//   - [metric.Int64Counter]
+//   - [metric.Int64UpDownCounter]
+//   - [metric.Int64Histogram]
+//   - [metric.Int64Gauge]
+//   - [metric.Float64Counter]
+//   - [metric.Float64UpDownCounter]
+//   - [metric.Float64Histogram]
+//   - [metric.Float64Gauge]
+//   - [metric.Meter]
+//   - [metric.MeterProvider]
+func Swappable[T any](inst T, s *SwappableAttributes) T {
+	if s == nil {
+		return inst
+	}
+
+	switch p := any(&inst).(type) {
+	case *metric.Int64Counter:
+		*p = swapInt64Counter{inst: *p, s: s}
+	case *metric.Int64UpDownCounter:
+		*p = swapInt64UpDownCounter{inst: *p, s: s}
+	case *metric.Int64Histogram:
+		*p = swapInt64Histogram{inst: *p, s: s}
+	case *metric.Int64Gauge:
+		*p = swapInt64Gauge{inst: *p, s: s}
+	case *metric.Float64Counter:
+		*p = swapFloat64Counter{inst: *p, s: s}
+	case *metric.Float64UpDownCounter:
+		*p = swapFloat64UpDownCounter{inst: *p, s: s}
+	case *metric.Float64Histogram:
+		*p = swapFloat64Histogram{inst: *p, s: s}
+	case *metric.Float64Gauge:
+		*p = swapFloat64Gauge{inst: *p, s: s}
+	case *metric.Meter:
+		*p = &swapMeter{Meter: *p, s: s}
+	case *metric.MeterProvider:
+		*p = &swapMeterProvider{mp: *p, s: s}
+	default:
+		panic("bind: unsupported type " + reflect.TypeFor[T]().String())
+	}
+	return inst
+}
@@ -0,0 +1,48 @@
+package bind
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+type swapFloat64Gauge struct {
+	embedded.Float64Gauge
+
+	inst metric.Float64Gauge
+	s    *SwappableAttributes
+}
+
+// Unwrap returns the underlying [metric.Float64Gauge] and the bound attribute
+// set, including the current swappable attributes.
+func (i swapFloat64Gauge) Unwrap() (metric.Float64Gauge, attribute.Set) {
+	inst, set := Unwrap(i.inst)
+	return inst, merge(set, i.s.Attributes())
+}
+
+// Enabled reports whether the underlying instrument will process measurements.
+func (i swapFloat64Gauge) Enabled(ctx context.Context) bool {
+	return i.inst.Enabled(ctx)
+}
+
+// Record records the instantaneous value. All measurements made will
+// include the current swappable attributes.
+func (i swapFloat64Gauge) Record(ctx context.Context, value float64, opts ...metric.RecordOption) {
+	st := i.s.load()
+	if len(opts) == 0 {
+		i.inst.Record(ctx, value, st.rec...)
+		return
+	}
+
+	o := recordOptPool.Get().(*[]metric.RecordOption)
+	defer func() {
+		*o = (*o)[:0]
+		recordOptPool.Put(o)
+	}()
+
+	*o = append(*o, st.rec...)
+	*o = append(*o, opts...)
+	i.inst.Record(ctx, value, *o...)
+}
@@ -0,0 +1,87 @@
+package bind
+
+import (
+	"context"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type swapFloat64ObservableCounter struct {
+	metric.Float64ObservableCounter
+
+	o *swapObservable
+}
+
+// Unwrap returns the underlying [metric.Float64ObservableCounter] and the bound
+// attribute set, including the current swappable attributes.
+func (i swapFloat64ObservableCounter) Unwrap() (metric.Float64ObservableCounter, attribute.Set) {
+	inst, set := Unwrap(i.Float64ObservableCounter)
+	return inst, merge(set, i.o.s.Attributes())
+}
+
+func (i swapFloat64ObservableCounter) base() (metric.Float64Observable, []metric.ObserveOption) {
+	return swapFloat64Base(i.Float64ObservableCounter, i.o)
+}
+
+type swapFloat64ObservableUpDownCounter struct {
+	metric.Float64ObservableUpDownCounter
+
+	o *swapObservable
+}
+
+// Unwrap returns the underlying [metric.Float64ObservableUpDownCounter] and the
+// bound attribute set, including the current swappable attributes.
+func (i swapFloat64ObservableUpDownCounter) Unwrap() (metric.Float64ObservableUpDownCounter, attribute.Set) {
+	inst, set := Unwrap(i.Float64ObservableUpDownCounter)
+	return inst, merge(set, i.o.s.Attributes())
+}
+
+func (i swapFloat64ObservableUpDownCounter) base() (metric.Float64Observable, []metric.ObserveOption) {
+	return swapFloat64Base(i.Float64ObservableUpDownCounter, i.o)
+}
+
+type swapFloat64ObservableGauge struct {
+	metric.Float64ObservableGauge
+
+	o *swapObservable
+}
+
+// Unwrap returns the underlying [metric.Float64ObservableGauge] and the bound
+// attribute set, including the current swappable attributes.
+func (i swapFloat64ObservableGauge) Unwrap() (metric.Float64ObservableGauge, attribute.Set) {
+	inst, set := Unwrap(i.Float64ObservableGauge)
+	return inst, merge(set, i.o.s.Attributes())
+}
+
+func (i swapFloat64ObservableGauge) base() (metric.Float64Observable, []metric.ObserveOption) {
+	return swapFloat64Base(i.Float64ObservableGauge, i.o)
+}
+
+// swapFloat64Base returns the instrument created by the underlying Meter for
+// inst and the options observations need to include: the options of any bound
+// attributes followed by those of the current attributes of o.
+func swapFloat64Base(inst metric.Float64Observable, o *swapObservable) (metric.Float64Observable, []metric.ObserveOption) {
+	b, ok := inst.(boundFloat64Observable)
+	if !ok {
+		return inst, o.options()
+	}
+	inst, bound := b.base()
+	if o.merged {
+		return inst, o.options()
+	}
+	return inst, append(slices.Clip(bound), o.s.load().obs...)
+}
+
+// swapFloat64Callback returns a [metric.Float64Callback] that calls cb with an
+// observer that includes the current attributes of o in all observations.
+func swapFloat64Callback(cb metric.Float64Callback, o *swapObservable) metric.Float64Callback {
+	return func(ctx context.Context, obs metric.Float64Observer) error {
+		if b, ok := obs.(float64Observer); ok && o.merged {
+			// Observe the bound attributes merged with the swappable ones.
+			obs = b.obs
+		}
+		return cb(ctx, float64Observer{obs: obs, o: o.options()})
+	}
+}
@@ -0,0 +1,48 @@
+package bind
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+type swapFloat64UpDownCounter struct {
+	embedded.Float64UpDownCounter
+
+	inst metric.Float64UpDownCounter
+	s    *SwappableAttributes
+}
+
+// Unwrap returns the underlying [metric.Float64UpDownCounter] and the bound
+// attribute set, including the current swappable attributes.
+func (i swapFloat64UpDownCounter) Unwrap() (metric.Float64UpDownCounter, attribute.Set) {
+	inst, set := Unwrap(i.inst)
+	return inst, merge(set, i.s.Attributes())
+}
+
+// Enabled reports whether the underlying instrument will process measurements.
+func (i swapFloat64UpDownCounter) Enabled(ctx context.Context) bool {
+	return i.inst.Enabled(ctx)
+}
+
+// Add increments or decrements the counter by incr. All measurements made will
+// include the current swappable attributes.
+func (i swapFloat64UpDownCounter) Add(ctx context.Context, incr float64, opts ...metric.AddOption) {
+	st := i.s.load()
+	if len(opts) == 0 {
+		i.inst.Add(ctx, incr, st.add...)
+		return
+	}
+
+	o := addOptPool.Get().(*[]metric.AddOption)
+	defer func() {
+		*o = (*o)[:0]
+		addOptPool.Put(o)
+	}()
+
+	*o = append(*o, st.add...)
+	*o = append(*o, opts...)
+	i.inst.Add(ctx, incr, *o...)
+}
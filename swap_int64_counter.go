@@ -0,0 +1,48 @@
+package bind
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+type swapInt64Counter struct {
+	embedded.Int64Counter
+
+	inst metric.Int64Counter
+	s    *SwappableAttributes
+}
+
+// Unwrap returns the underlying [metric.Int64Counter] and the bound attribute
+// set, including the current swappable attributes.
+func (i swapInt64Counter) Unwrap() (metric.Int64Counter, attribute.Set) {
+	inst, set := Unwrap(i.inst)
+	return inst, merge(set, i.s.Attributes())
+}
+
+// Enabled reports whether the underlying instrument will process measurements.
+func (i swapInt64Counter) Enabled(ctx context.Context) bool {
+	return i.inst.Enabled(ctx)
+}
+
+// Add increments the counter by incr. All measurements made will
+// include the current swappable attributes.
+func (i swapInt64Counter) Add(ctx context.Context, incr int64, opts ...metric.AddOption) {
+	st := i.s.load()
+	if len(opts) == 0 {
+		i.inst.Add(ctx, incr, st.add...)
+		return
+	}
+
+	o := addOptPool.Get().(*[]metric.AddOption)
+	defer func() {
+		*o = (*o)[:0]
+		addOptPool.Put(o)
+	}()
+
+	*o = append(*o, st.add...)
+	*o = append(*o, opts...)
+	i.inst.Add(ctx, incr, *o...)
+}
@@ -0,0 +1,48 @@
+package bind
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+type swapInt64Gauge struct {
+	embedded.Int64Gauge
+
+	inst metric.Int64Gauge
+	s    *SwappableAttributes
+}
+
+// Unwrap returns the underlying [metric.Int64Gauge] and the bound attribute
+// set, including the current swappable attributes.
+func (i swapInt64Gauge) Unwrap() (metric.Int64Gauge, attribute.Set) {
+	inst, set := Unwrap(i.inst)
+	return inst, merge(set, i.s.Attributes())
+}
+
+// Enabled reports whether the underlying instrument will process measurements.
+func (i swapInt64Gauge) Enabled(ctx context.Context) bool {
+	return i.inst.Enabled(ctx)
+}
+
+// Record records the instantaneous value. All measurements made will
+// include the current swappable attributes.
+func (i swapInt64Gauge) Record(ctx context.Context, value int64, opts ...metric.RecordOption) {
+	st := i.s.load()
+	if len(opts) == 0 {
+		i.inst.Record(ctx, value, st.rec...)
+		return
+	}
+
+	o := recordOptPool.Get().(*[]metric.RecordOption)
+	defer func() {
+		*o = (*o)[:0]
+		recordOptPool.Put(o)
+	}()
+
+	*o = append(*o, st.rec...)
+	*o = append(*o, opts...)
+	i.inst.Record(ctx, value, *o...)
+}
@@ -0,0 +1,48 @@
+package bind
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+type swapInt64Histogram struct {
+	embedded.Int64Histogram
+
+	inst metric.Int64Histogram
+	s    *SwappableAttributes
+}
+
+// Unwrap returns the underlying [metric.Int64Histogram] and the bound attribute
+// set, including the current swappable attributes.
+func (i swapInt64Histogram) Unwrap() (metric.Int64Histogram, attribute.Set) {
+	inst, set := Unwrap(i.inst)
+	return inst, merge(set, i.s.Attributes())
+}
+
+// Enabled reports whether the underlying instrument will process measurements.
+func (i swapInt64Histogram) Enabled(ctx context.Context) bool {
+	return i.inst.Enabled(ctx)
+}
+
+// Record adds a value to the histogram. All measurements made will
+// include the current swappable attributes.
+func (i swapInt64Histogram) Record(ctx context.Context, value int64, opts ...metric.RecordOption) {
+	st := i.s.load()
+	if len(opts) == 0 {
+		i.inst.Record(ctx, value, st.rec...)
+		return
+	}
+
+	o := recordOptPool.Get().(*[]metric.RecordOption)
+	defer func() {
+		*o = (*o)[:0]
+		recordOptPool.Put(o)
+	}()
+
+	*o = append(*o, st.rec...)
+	*o = append(*o, opts...)
+	i.inst.Record(ctx, value, *o...)
+}
@@ -0,0 +1,87 @@
+package bind
+
+import (
+	"context"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type swapInt64ObservableCounter struct {
+	metric.Int64ObservableCounter
+
+	o *swapObservable
+}
+
+// Unwrap returns the underlying [metric.Int64ObservableCounter] and the bound
+// attribute set, including the current swappable attributes.
+func (i swapInt64ObservableCounter) Unwrap() (metric.Int64ObservableCounter, attribute.Set) {
+	inst, set := Unwrap(i.Int64ObservableCounter)
+	return inst, merge(set, i.o.s.Attributes())
+}
+
+func (i swapInt64ObservableCounter) base() (metric.Int64Observable, []metric.ObserveOption) {
+	return swapInt64Base(i.Int64ObservableCounter, i.o)
+}
+
+type swapInt64ObservableUpDownCounter struct {
+	metric.Int64ObservableUpDownCounter
+
+	o *swapObservable
+}
+
+// Unwrap returns the underlying [metric.Int64ObservableUpDownCounter] and the
+// bound attribute set, including the current swappable attributes.
+func (i swapInt64ObservableUpDownCounter) Unwrap() (metric.Int64ObservableUpDownCounter, attribute.Set) {
+	inst, set := Unwrap(i.Int64ObservableUpDownCounter)
+	return inst, merge(set, i.o.s.Attributes())
+}
+
+func (i swapInt64ObservableUpDownCounter) base() (metric.Int64Observable, []metric.ObserveOption) {
+	return swapInt64Base(i.Int64ObservableUpDownCounter, i.o)
+}
+
+type swapInt64ObservableGauge struct {
+	metric.Int64ObservableGauge
+
+	o *swapObservable
+}
+
+// Unwrap returns the underlying [metric.Int64ObservableGauge] and the bound
+// attribute set, including the current swappable attributes.
+func (i swapInt64ObservableGauge) Unwrap() (metric.Int64ObservableGauge, attribute.Set) {
+	inst, set := Unwrap(i.Int64ObservableGauge)
+	return inst, merge(set, i.o.s.Attributes())
+}
+
+func (i swapInt64ObservableGauge) base() (metric.Int64Observable, []metric.ObserveOption) {
+	return swapInt64Base(i.Int64ObservableGauge, i.o)
+}
+
+// swapInt64Base returns the instrument created by the underlying Meter for
+// inst and the options observations need to include: the options of any bound
+// attributes followed by those of the current attributes of o.
+func swapInt64Base(inst metric.Int64Observable, o *swapObservable) (metric.Int64Observable, []metric.ObserveOption) {
+	b, ok := inst.(boundInt64Observable)
+	if !ok {
+		return inst, o.options()
+	}
+	inst, bound := b.base()
+	if o.merged {
+		return inst, o.options()
+	}
+	return inst, append(slices.Clip(bound), o.s.load().obs...)
+}
+
+// swapInt64Callback returns a [metric.Int64Callback] that calls cb with an
+// observer that includes the current attributes of o in all observations.
+func swapInt64Callback(cb metric.Int64Callback, o *swapObservable) metric.Int64Callback {
+	return func(ctx context.Context, obs metric.Int64Observer) error {
+		if b, ok := obs.(int64Observer); ok && o.merged {
+			// Observe the bound attributes merged with the swappable ones.
+			obs = b.obs
+		}
+		return cb(ctx, int64Observer{obs: obs, o: o.options()})
+	}
+}
@@ -0,0 +1,251 @@
+package bind
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+type swapMeter struct {
+	metric.Meter
+
+	s *SwappableAttributes
+}
+
+var (
+	_ metric.Meter            = (*swapMeter)(nil)
+	_ unwrapper[metric.Meter] = (*swapMeter)(nil)
+)
+
+// Unwrap returns the underlying [metric.Meter] and the bound attribute set,
+// including the current swappable attributes.
+func (m *swapMeter) Unwrap() (metric.Meter, attribute.Set) {
+	inst, set := Unwrap(m.Meter)
+	return inst, merge(set, m.s.Attributes())
+}
+
+func (m *swapMeter) Int64Counter(name string, options ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	inst, err := m.Meter.Int64Counter(name, options...)
+	if inst != nil {
+		inst = swapInt64Counter{inst: inst, s: m.s}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Int64UpDownCounter(name string, options ...metric.Int64UpDownCounterOption) (metric.Int64UpDownCounter, error) {
+	inst, err := m.Meter.Int64UpDownCounter(name, options...)
+	if inst != nil {
+		inst = swapInt64UpDownCounter{inst: inst, s: m.s}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Int64Histogram(name string, options ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
+	inst, err := m.Meter.Int64Histogram(name, options...)
+	if inst != nil {
+		inst = swapInt64Histogram{inst: inst, s: m.s}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Int64Gauge(name string, options ...metric.Int64GaugeOption) (metric.Int64Gauge, error) {
+	inst, err := m.Meter.Int64Gauge(name, options...)
+	if inst != nil {
+		inst = swapInt64Gauge{inst: inst, s: m.s}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Float64Counter(name string, options ...metric.Float64CounterOption) (metric.Float64Counter, error) {
+	inst, err := m.Meter.Float64Counter(name, options...)
+	if inst != nil {
+		inst = swapFloat64Counter{inst: inst, s: m.s}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Float64UpDownCounter(name string, options ...metric.Float64UpDownCounterOption) (metric.Float64UpDownCounter, error) {
+	inst, err := m.Meter.Float64UpDownCounter(name, options...)
+	if inst != nil {
+		inst = swapFloat64UpDownCounter{inst: inst, s: m.s}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Float64Histogram(name string, options ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	inst, err := m.Meter.Float64Histogram(name, options...)
+	if inst != nil {
+		inst = swapFloat64Histogram{inst: inst, s: m.s}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Float64Gauge(name string, options ...metric.Float64GaugeOption) (metric.Float64Gauge, error) {
+	inst, err := m.Meter.Float64Gauge(name, options...)
+	if inst != nil {
+		inst = swapFloat64Gauge{inst: inst, s: m.s}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Int64ObservableCounter(name string, options ...metric.Int64ObservableCounterOption) (metric.Int64ObservableCounter, error) {
+	o := m.observable(name, KindInt64ObservableCounter)
+	cfg := metric.NewInt64ObservableCounterConfig(options...)
+	if cbs := cfg.Callbacks(); len(cbs) > 0 {
+		// Replace the callbacks with ones that observe the swappable attributes.
+		options = make([]metric.Int64ObservableCounterOption, 0, len(cbs)+2)
+		options = append(options, metric.WithDescription(cfg.Description()), metric.WithUnit(cfg.Unit()))
+		for _, cb := range cbs {
+			options = append(options, metric.WithInt64Callback(swapInt64Callback(cb, o)))
+		}
+	}
+
+	inst, err := m.Meter.Int64ObservableCounter(name, options...)
+	if inst != nil {
+		inst = swapInt64ObservableCounter{Int64ObservableCounter: inst, o: o}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Int64ObservableUpDownCounter(name string, options ...metric.Int64ObservableUpDownCounterOption) (metric.Int64ObservableUpDownCounter, error) {
+	o := m.observable(name, KindInt64ObservableUpDownCounter)
+	cfg := metric.NewInt64ObservableUpDownCounterConfig(options...)
+	if cbs := cfg.Callbacks(); len(cbs) > 0 {
+		// Replace the callbacks with ones that observe the swappable attributes.
+		options = make([]metric.Int64ObservableUpDownCounterOption, 0, len(cbs)+2)
+		options = append(options, metric.WithDescription(cfg.Description()), metric.WithUnit(cfg.Unit()))
+		for _, cb := range cbs {
+			options = append(options, metric.WithInt64Callback(swapInt64Callback(cb, o)))
+		}
+	}
+
+	inst, err := m.Meter.Int64ObservableUpDownCounter(name, options...)
+	if inst != nil {
+		inst = swapInt64ObservableUpDownCounter{Int64ObservableUpDownCounter: inst, o: o}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Int64ObservableGauge(name string, options ...metric.Int64ObservableGaugeOption) (metric.Int64ObservableGauge, error) {
+	o := m.observable(name, KindInt64ObservableGauge)
+	cfg := metric.NewInt64ObservableGaugeConfig(options...)
+	if cbs := cfg.Callbacks(); len(cbs) > 0 {
+		// Replace the callbacks with ones that observe the swappable attributes.
+		options = make([]metric.Int64ObservableGaugeOption, 0, len(cbs)+2)
+		options = append(options, metric.WithDescription(cfg.Description()), metric.WithUnit(cfg.Unit()))
+		for _, cb := range cbs {
+			options = append(options, metric.WithInt64Callback(swapInt64Callback(cb, o)))
+		}
+	}
+
+	inst, err := m.Meter.Int64ObservableGauge(name, options...)
+	if inst != nil {
+		inst = swapInt64ObservableGauge{Int64ObservableGauge: inst, o: o}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Float64ObservableCounter(name string, options ...metric.Float64ObservableCounterOption) (metric.Float64ObservableCounter, error) {
+	o := m.observable(name, KindFloat64ObservableCounter)
+	cfg := metric.NewFloat64ObservableCounterConfig(options...)
+	if cbs := cfg.Callbacks(); len(cbs) > 0 {
+		// Replace the callbacks with ones that observe the swappable attributes.
+		options = make([]metric.Float64ObservableCounterOption, 0, len(cbs)+2)
+		options = append(options, metric.WithDescription(cfg.Description()), metric.WithUnit(cfg.Unit()))
+		for _, cb := range cbs {
+			options = append(options, metric.WithFloat64Callback(swapFloat64Callback(cb, o)))
+		}
+	}
+
+	inst, err := m.Meter.Float64ObservableCounter(name, options...)
+	if inst != nil {
+		inst = swapFloat64ObservableCounter{Float64ObservableCounter: inst, o: o}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Float64ObservableUpDownCounter(name string, options ...metric.Float64ObservableUpDownCounterOption) (metric.Float64ObservableUpDownCounter, error) {
+	o := m.observable(name, KindFloat64ObservableUpDownCounter)
+	cfg := metric.NewFloat64ObservableUpDownCounterConfig(options...)
+	if cbs := cfg.Callbacks(); len(cbs) > 0 {
+		// Replace the callbacks with ones that observe the swappable attributes.
+		options = make([]metric.Float64ObservableUpDownCounterOption, 0, len(cbs)+2)
+		options = append(options, metric.WithDescription(cfg.Description()), metric.WithUnit(cfg.Unit()))
+		for _, cb := range cbs {
+			options = append(options, metric.WithFloat64Callback(swapFloat64Callback(cb, o)))
+		}
+	}
+
+	inst, err := m.Meter.Float64ObservableUpDownCounter(name, options...)
+	if inst != nil {
+		inst = swapFloat64ObservableUpDownCounter{Float64ObservableUpDownCounter: inst, o: o}
+	}
+	return inst, err
+}
+
+func (m *swapMeter) Float64ObservableGauge(name string, options ...metric.Float64ObservableGaugeOption) (metric.Float64ObservableGauge, error) {
+	o := m.observable(name, KindFloat64ObservableGauge)
+	cfg := metric.NewFloat64ObservableGaugeConfig(options...)
+	if cbs := cfg.Callbacks(); len(cbs) > 0 {
+		// Replace the callbacks with ones that observe the swappable attributes.
+		options = make([]metric.Float64ObservableGaugeOption, 0, len(cbs)+2)
+		options = append(options, metric.WithDescription(cfg.Description()), metric.WithUnit(cfg.Unit()))
+		for _, cb := range cbs {
+			options = append(options, metric.WithFloat64Callback(swapFloat64Callback(cb, o)))
+		}
+	}
+
+	inst, err := m.Meter.Float64ObservableGauge(name, options...)
+	if inst != nil {
+		inst = swapFloat64ObservableGauge{Float64ObservableGauge: inst, o: o}
+	}
+	return inst, err
+}
+
+// observable returns the state of an asynchronous instrument named name of
+// kind created by m.
+func (m *swapMeter) observable(name string, kind InstrumentKind) *swapObservable {
+	o := &swapObservable{s: m.s}
+	if bm, ok := m.Meter.(*meter); ok {
+		// Merge the attributes bound by the underlying Meter with the
+		// swappable attributes once per state instead of per observation.
+		var b *meter
+		b, o.merged = bm.binding(name, kind)
+		if o.merged {
+			o.bound = b.set
+		}
+	}
+	return o
+}
+
+// RegisterCallback registers f to be called during the collection of a
+// measurement cycle. The [metric.Observer] passed to f will include the
+// current swappable attributes of any instrument created by m it observes.
+func (m *swapMeter) RegisterCallback(f metric.Callback, instruments ...metric.Observable) (metric.Registration, error) {
+	return registerCallback(m.Meter, f, instruments)
+}
+
+type swapMeterProvider struct {
+	embedded.MeterProvider
+
+	mp metric.MeterProvider
+	s  *SwappableAttributes
+}
+
+var (
+	_ metric.MeterProvider            = (*swapMeterProvider)(nil)
+	_ unwrapper[metric.MeterProvider] = (*swapMeterProvider)(nil)
+)
+
+// Unwrap returns the underlying [metric.MeterProvider] and the bound
+// attribute set, including the current swappable attributes.
+func (p *swapMeterProvider) Unwrap() (metric.MeterProvider, attribute.Set) {
+	mp, set := Unwrap(p.mp)
+	return mp, merge(set, p.s.Attributes())
+}
+
+// Meter returns a [metric.Meter] from the underlying provider bound to the
+// swappable attributes.
+func (p *swapMeterProvider) Meter(name string, opts ...metric.MeterOption) metric.Meter {
+	return Swappable(p.mp.Meter(name, opts...), p.s)
+}
@@ -0,0 +1,281 @@
+package bind_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+var (
+	roleLeader   = attribute.String("role", "leader")
+	roleFollower = attribute.String("role", "follower")
+)
+
+func testSwappable[T any, N any](mock Mock[T, N], b Binder[T], m Measure[T, N], val N) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		s := bind.NewSwappableAttributes(roleLeader, attribute.String("user", "bob"))
+		inst := bind.Swappable(b(mock.Instrument(), userAlice, userID), s)
+
+		m(inst, context.Background(), val, nil)
+		_, got := mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{attribute.String("user", "bob"), userID, roleLeader}, got, "swappable attributes")
+
+		s.Set(roleFollower)
+		m(inst, context.Background(), val, []attribute.KeyValue{adminTrue})
+		_, got = mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID, roleFollower, adminTrue}, got, "replaced attributes")
+
+		m(inst, context.Background(), val, []attribute.KeyValue{roleLeader})
+		_, got = mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID, roleLeader}, got, "call-site precedence")
+
+		raw, set := bind.Unwrap(inst)
+		assert.Equal(t, mock.Instrument(), raw, "unwrapped instrument")
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID, roleFollower}, set.ToSlice(), "unwrapped attributes")
+	}
+}
+
+func TestSwappable(t *testing.T) {
+	t.Run("Int64Counter", testSwappable(&mockInt64Counter{}, bind.Int64Counter, measInt64Counter, 1))
+	t.Run("Int64UpDownCounter", testSwappable(&mockInt64UpDownCounter{}, bind.Int64UpDownCounter, measInt64UpDownCounter, 1))
+	t.Run("Int64Histogram", testSwappable(&mockInt64Histogram{}, bind.Int64Histogram, measInt64Histogram, 1))
+	t.Run("Int64Gauge", testSwappable(&mockInt64Gauge{}, bind.Int64Gauge, measInt64Gauge, 1))
+	t.Run("Float64Counter", testSwappable(&mockFloat64Counter{}, bind.Float64Counter, measFloat64Counter, 1))
+	t.Run("Float64UpDownCounter", testSwappable(&mockFloat64UpDownCounter{}, bind.Float64UpDownCounter, measFloat64UpDownCounter, 1))
+	t.Run("Float64Histogram", testSwappable(&mockFloat64Histogram{}, bind.Float64Histogram, measFloat64Histogram, 1))
+	t.Run("Float64Gauge", testSwappable(&mockFloat64Gauge{}, bind.Float64Gauge, measFloat64Gauge, 1))
+}
+
+func TestSwappableNil(t *testing.T) {
+	mock := &mockInt64Counter{}
+	inst := bind.Swappable(bind.Int64Counter(mock, userAlice), nil)
+
+	require.NotPanics(t, func() { inst.Add(context.Background(), 1, metric.WithAttributes(userID)) })
+	_, got := mock.Recorded()
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, got)
+}
+
+func TestSwappableAttributesNoSideEffects(t *testing.T) {
+	attrs, cp := clone(attribute.Int("C", 3), attribute.Int("B", 2))
+	s := bind.NewSwappableAttributes(attrs...)
+	assert.Equal(t, cp, attrs)
+
+	s.Set(attrs...)
+	assert.Equal(t, cp, attrs)
+}
+
+func TestSwappableAttributesUpdate(t *testing.T) {
+	s := bind.NewSwappableAttributes(attribute.Int("n", 0))
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			s.Update(func(attrs []attribute.KeyValue) []attribute.KeyValue {
+				i := slices.IndexFunc(attrs, func(kv attribute.KeyValue) bool { return kv.Key == "n" })
+				attrs[i] = attribute.Int64("n", attrs[i].Value.AsInt64()+1)
+				return attrs
+			})
+		})
+	}
+	wg.Wait()
+
+	set := s.Attributes()
+	v, _ := set.Value("n")
+	assert.Equal(t, int64(10), v.AsInt64(), "updates should not be lost")
+}
+
+func TestSwappableConcurrentSafe(t *testing.T) {
+	s := bind.NewSwappableAttributes(roleLeader)
+	inst := bind.Swappable[metric.Float64Counter](noop.Float64Counter{}, s)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Go(func() {
+			for range 100 {
+				inst.Add(context.Background(), 1, metric.WithAttributes(userID))
+			}
+		})
+		wg.Go(func() {
+			for range 100 {
+				s.Set(roleFollower)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func TestSwappableAllocs(t *testing.T) {
+	s := bind.NewSwappableAttributes(roleLeader)
+	inst := bind.Swappable[metric.Float64Counter](noop.Float64Counter{}, s)
+	ctx := context.Background()
+
+	allocs := testing.AllocsPerRun(100, func() { inst.Add(ctx, 1) })
+	assert.Zero(t, allocs, "measurement should not allocate")
+}
+
+func TestSwappableMeter(t *testing.T) {
+	mock := &mockMeter{}
+	s := bind.NewSwappableAttributes(roleLeader)
+	meter := bind.Swappable(bind.Meter(mock, userAlice), s)
+
+	counter, err := meter.Int64Counter("counter")
+	require.NoError(t, err)
+	counter.Add(context.Background(), 1)
+	s.Set(roleFollower)
+	counter.Add(context.Background(), 1)
+
+	raw, set := bind.Unwrap(counter)
+	assert.IsType(t, &mockInt64Counter{}, raw)
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, roleFollower}, set.ToSlice())
+
+	_, set = bind.Unwrap(meter)
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, roleFollower}, set.ToSlice())
+}
+
+func TestSwappableMeterCallback(t *testing.T) {
+	mock := &mockMeter{}
+	s := bind.NewSwappableAttributes(roleLeader)
+	meter := bind.Swappable(bind.Meter(mock, userAlice), s)
+
+	cb := func(_ context.Context, o metric.Int64Observer) error {
+		o.Observe(1, metric.WithAttributes(adminTrue))
+		return nil
+	}
+	_, err := meter.Int64ObservableCounter("counter", metric.WithInt64Callback(cb))
+	require.NoError(t, err)
+	require.Len(t, mock.int64Callbacks, 1)
+
+	s.Set(roleFollower)
+	obs := &mockInt64Observer{}
+	require.NoError(t, mock.int64Callbacks[0](context.Background(), obs))
+
+	require.Len(t, obs.got, 1)
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, roleFollower, adminTrue}, obs.got[0].attrs)
+}
+
+func TestSwappableMeterCallbackSet(t *testing.T) {
+	mock := &mockMeter{}
+	s := bind.NewSwappableAttributes(roleLeader, attribute.String("user", "bob"))
+	meter := bind.Swappable(bind.Meter(mock, userAlice, userID), s)
+
+	cb := func(_ context.Context, o metric.Float64Observer) error {
+		o.Observe(1)
+		return nil
+	}
+	_, err := meter.Float64ObservableGauge("gauge", metric.WithFloat64Callback(cb))
+	require.NoError(t, err)
+	require.Len(t, mock.float64Callbacks, 1)
+
+	obs := &mockFloat64Observer{}
+	require.NoError(t, mock.float64Callbacks[0](context.Background(), obs))
+	s.Set(roleFollower)
+	require.NoError(t, mock.float64Callbacks[0](context.Background(), obs))
+
+	require.Len(t, obs.got, 2)
+	assert.ElementsMatch(t, []attribute.KeyValue{attribute.String("user", "bob"), userID, roleLeader}, obs.got[0].attrs, "swappable attributes")
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID, roleFollower}, obs.got[1].attrs, "replaced attributes")
+}
+
+func TestSwappableMeterRegisterCallback(t *testing.T) {
+	mock := &mockMeter{}
+	s := bind.NewSwappableAttributes(roleLeader)
+	meter := bind.Swappable(bind.Meter(mock, userAlice), s)
+
+	fGauge, err := meter.Float64ObservableGauge("float64")
+	require.NoError(t, err)
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveFloat64(fGauge, 2, metric.WithAttributes(adminTrue))
+		return nil
+	}, fGauge)
+	require.NoError(t, err)
+
+	base, _ := bind.Unwrap(fGauge)
+	assert.Equal(t, []metric.Observable{base}, mock.insts, "underlying meter should be passed unbound instruments")
+
+	s.Set(roleFollower)
+	obs := &mockObserver{}
+	require.NoError(t, mock.callback(context.Background(), obs))
+
+	require.Len(t, obs.got, 1)
+	assert.Equal(t, base, obs.got[0].inst)
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, roleFollower, adminTrue}, obs.got[0].attrs)
+}
+
+func TestSwappableMeterProvider(t *testing.T) {
+	mock := &mockMeterProvider{}
+	s := bind.NewSwappableAttributes(roleLeader)
+	mp := bind.Swappable(bind.MeterProvider(mock, userAlice), s)
+
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, roleLeader}, meterAttrs(t, mp.Meter("scope")))
+
+	raw, set := bind.Unwrap(mp)
+	assert.Same(t, mock, raw)
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, roleLeader}, set.ToSlice())
+}
+
+func BenchmarkSwappable(b *testing.B) {
+	s := bind.NewSwappableAttributes(roleLeader)
+	inst := bind.Swappable[metric.Float64Counter](noop.Float64Counter{}, s)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		inst.Add(ctx, 1)
+	}
+}
+
+// BenchmarkSwappableObservable observes two values per callback. Only the
+// callback is expected to allocate, not the observations.
+func BenchmarkSwappableObservable(b *testing.B) {
+	mock := &mockMeter{}
+	s := bind.NewSwappableAttributes(roleLeader)
+	meter := bind.Swappable(bind.Meter(mock, userAlice), s)
+	ctx := context.Background()
+
+	b.Run("Callback", func(b *testing.B) {
+		mock.int64Callbacks = nil
+		cb := func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(1)
+			o.Observe(2)
+			return nil
+		}
+		_, err := meter.Int64ObservableCounter("counter", metric.WithInt64Callback(cb))
+		require.NoError(b, err)
+		require.Len(b, mock.int64Callbacks, 1)
+		f := mock.int64Callbacks[0]
+		obs := noop.Int64Observer{}
+
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = f(ctx, obs)
+		}
+	})
+
+	b.Run("RegisterCallback", func(b *testing.B) {
+		gauge, err := meter.Float64ObservableGauge("gauge")
+		require.NoError(b, err)
+		_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+			o.ObserveFloat64(gauge, 1)
+			o.ObserveFloat64(gauge, 2)
+			return nil
+		}, gauge)
+		require.NoError(b, err)
+		obs := noop.Observer{}
+
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = mock.callback(ctx, obs)
+		}
+	})
+}
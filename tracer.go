@@ -0,0 +1,120 @@
+package bind
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+)
+
+// Tracer binds attrs to t. All spans started with the returned
+// [trace.Tracer] include attrs as if passed to Start using
+// [trace.WithAttributes]. Attributes passed when starting a span take
+// precedence over attrs.
+//
+// If t is already bound to attributes, attrs will be merged into those
+// attributes for the returned tracer.
+func Tracer(t trace.Tracer, attrs ...attribute.KeyValue) trace.Tracer {
+	if len(attrs) == 0 {
+		return t
+	}
+
+	var set attribute.Set
+	if i, ok := t.(*tracer); ok {
+		// Flatten the tracer if already bound.
+		t = i.tracer
+		all := make([]attribute.KeyValue, 0, i.set.Len()+len(attrs))
+		all = append(all, i.set.ToSlice()...)
+		set = attribute.NewSet(append(all, attrs...)...)
+	} else {
+		// NewSet sorts passed attributes. Copy to avoid side effect.
+		cp := make([]attribute.KeyValue, len(attrs))
+		copy(cp, attrs)
+		set = attribute.NewSet(cp...)
+	}
+
+	return &tracer{
+		tracer: t,
+		set:    set,
+		opt:    trace.WithAttributes(set.ToSlice()...),
+	}
+}
+
+type tracer struct {
+	embedded.Tracer
+
+	tracer trace.Tracer
+	set    attribute.Set
+	// opt is the start option that adds the bound attributes to a span.
+	opt trace.SpanStartOption
+}
+
+var (
+	_ trace.Tracer            = (*tracer)(nil)
+	_ unwrapper[trace.Tracer] = (*tracer)(nil)
+)
+
+// Unwrap returns the underlying [trace.Tracer] and bound attributes.
+func (t *tracer) Unwrap() (trace.Tracer, attribute.Set) {
+	return t.tracer, t.set
+}
+
+// Start starts a span using the underlying [trace.Tracer] with the bound
+// attributes added.
+func (t *tracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	// Prepend the bound attributes so attributes passed in opts override
+	// them.
+	o := make([]trace.SpanStartOption, 0, len(opts)+1)
+	o = append(o, t.opt)
+	return t.tracer.Start(ctx, name, append(o, opts...)...)
+}
+
+// TracerProvider binds attrs to tp. All [trace.Tracer] returned by the
+// returned [trace.TracerProvider] will be bound to attrs (see [Tracer]).
+//
+// If tp is already bound to attributes, attrs will be merged into those
+// attributes for the returned provider.
+func TracerProvider(tp trace.TracerProvider, attrs ...attribute.KeyValue) trace.TracerProvider {
+	if len(attrs) == 0 {
+		return tp
+	}
+
+	var all []attribute.KeyValue
+	if p, ok := tp.(*tracerProvider); ok {
+		// Flatten the provider if already bound.
+		tp = p.tp
+		all = make([]attribute.KeyValue, 0, p.set.Len()+len(attrs))
+		all = append(all, p.set.ToSlice()...)
+	} else {
+		// NewSet sorts passed attributes. Copy to avoid side effect.
+		all = make([]attribute.KeyValue, 0, len(attrs))
+	}
+	set := attribute.NewSet(append(all, attrs...)...)
+
+	return &tracerProvider{tp: tp, set: set, attrs: set.ToSlice()}
+}
+
+type tracerProvider struct {
+	embedded.TracerProvider
+
+	tp    trace.TracerProvider
+	set   attribute.Set
+	attrs []attribute.KeyValue
+}
+
+var (
+	_ trace.TracerProvider            = (*tracerProvider)(nil)
+	_ unwrapper[trace.TracerProvider] = (*tracerProvider)(nil)
+)
+
+// Unwrap returns the underlying [trace.TracerProvider] and bound attributes.
+func (p *tracerProvider) Unwrap() (trace.TracerProvider, attribute.Set) {
+	return p.tp, p.set
+}
+
+// Tracer returns a [trace.Tracer] from the underlying provider bound to the
+// provider's attributes.
+func (p *tracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.Tracer {
+	return Tracer(p.tp.Tracer(name, opts...), p.attrs...)
+}
@@ -0,0 +1,101 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type mockTracer struct {
+	noop.Tracer
+
+	name  string
+	attrs []attribute.KeyValue
+}
+
+// Start records the attributes of the span as the SDK would, with later
+// attributes overriding earlier ones with the same key.
+func (t *mockTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	cfg := trace.NewSpanStartConfig(opts...)
+	set := attribute.NewSet(cfg.Attributes()...)
+	t.attrs = set.ToSlice()
+	return t.Tracer.Start(ctx, name, opts...)
+}
+
+type mockTracerProvider struct {
+	noop.TracerProvider
+
+	tracers []*mockTracer
+}
+
+func (p *mockTracerProvider) Tracer(name string, _ ...trace.TracerOption) trace.Tracer {
+	t := &mockTracer{name: name}
+	p.tracers = append(p.tracers, t)
+	return t
+}
+
+func TestTracer(t *testing.T) {
+	mock := new(mockTracer)
+	tracer := bind.Tracer(mock, userAlice, userID)
+
+	_, _ = tracer.Start(t.Context(), "span")
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID}, mock.attrs)
+
+	// Attributes passed when starting a span take precedence.
+	bob := attribute.String("user", "bob")
+	_, _ = tracer.Start(t.Context(), "span", trace.WithAttributes(bob, adminTrue))
+	assert.ElementsMatch(t, []attribute.KeyValue{bob, userID, adminTrue}, mock.attrs)
+}
+
+func TestTracerFlatten(t *testing.T) {
+	mock := new(mockTracer)
+	bob := attribute.String("user", "bob")
+	tracer := bind.Tracer(bind.Tracer(mock, userAlice, userID), bob)
+
+	inner, set := bind.Unwrap(tracer)
+	assert.Same(t, mock, inner, "flattened")
+	assert.Equal(t, attribute.NewSet(bob, userID), set)
+
+	_, _ = tracer.Start(t.Context(), "span")
+	assert.ElementsMatch(t, []attribute.KeyValue{bob, userID}, mock.attrs)
+}
+
+func TestTracerNoAttributes(t *testing.T) {
+	mock := new(mockTracer)
+	assert.Same(t, mock, bind.Tracer(mock))
+
+	var tp trace.TracerProvider = new(mockTracerProvider)
+	assert.Same(t, tp, bind.TracerProvider(tp))
+}
+
+func TestTracerProvider(t *testing.T) {
+	mock := new(mockTracerProvider)
+	tp := bind.TracerProvider(bind.TracerProvider(mock, userAlice), adminTrue)
+
+	inner, set := bind.Unwrap(tp)
+	assert.Same(t, mock, inner, "flattened")
+	assert.Equal(t, attribute.NewSet(userAlice, adminTrue), set)
+
+	_, _ = tp.Tracer("scope").Start(t.Context(), "span", trace.WithAttributes(userID))
+	require.Len(t, mock.tracers, 1)
+	assert.Equal(t, "scope", mock.tracers[0].name)
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, adminTrue, userID}, mock.tracers[0].attrs)
+}
+
+func TestBindTracer(t *testing.T) {
+	mock := new(mockTracer)
+	tracer := bind.Bind[trace.Tracer](mock, userAlice)
+	_, _ = tracer.Start(t.Context(), "span")
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice}, mock.attrs)
+
+	mp := new(mockTracerProvider)
+	tp := bind.Bind[trace.TracerProvider](mp, userAlice)
+	_, set := bind.Unwrap(tp)
+	assert.Equal(t, attribute.NewSet(userAlice), set)
+}
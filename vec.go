@@ -0,0 +1,323 @@
+package bind
+
+import (
+	"container/list"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// VecOption configures a [Vec].
+type VecOption interface {
+	applyVec(vecConfig) vecConfig
+}
+
+type vecOptionFunc func(vecConfig) vecConfig
+
+func (f vecOptionFunc) applyVec(c vecConfig) vecConfig { return f(c) }
+
+type vecConfig struct {
+	maxEntries  int
+	idleTimeout time.Duration
+}
+
+// WithMaxEntries returns a [VecOption] that limits the number of bound
+// instruments a [Vec] caches to n. When the limit is reached, the least
+// recently used instrument is evicted. If n is less than or equal to zero, the
+// number of instruments is not limited.
+func WithMaxEntries(n int) VecOption {
+	return vecOptionFunc(func(c vecConfig) vecConfig {
+		c.maxEntries = max(n, 0)
+		return c
+	})
+}
+
+// WithIdleTimeout returns a [VecOption] that evicts bound instruments a [Vec]
+// caches once they have not been used for d. Idle instruments are evicted the
+// next time the Vec is used. If d is less than or equal to zero, instruments
+// are not evicted based on use.
+func WithIdleTimeout(d time.Duration) VecOption {
+	return vecOptionFunc(func(c vecConfig) vecConfig {
+		c.idleTimeout = max(d, 0)
+		return c
+	})
+}
+
+// Vec is a family of instruments bound to the same attribute keys, but with
+// different values. It provides bound instruments for a tuple of values,
+// similar to a Prometheus metric vector, and caches them for reuse.
+//
+// Use the constructor for an instrument kind (e.g. [NewFloat64CounterVec]) to
+// create a Vec. A Vec is safe for concurrent use.
+type Vec[T any] struct {
+	inst T
+	keys []attribute.Key
+	bind func(T, ...attribute.KeyValue) T
+	cfg  vecConfig
+
+	mu      sync.RWMutex
+	entries map[string]*list.Element
+	// lru orders entries from most (front) to least (back) recently used. It
+	// is only maintained if entry use is tracked.
+	lru *list.List
+}
+
+type vecEntry[T any] struct {
+	key  string
+	inst T
+	// lastUsed is the time the entry was last used.
+	lastUsed time.Time
+}
+
+func newVec[T any](inst T, bind func(T, ...attribute.KeyValue) T, keys []string, opts []VecOption) *Vec[T] {
+	var cfg vecConfig
+	for _, o := range opts {
+		cfg = o.applyVec(cfg)
+	}
+
+	k := make([]attribute.Key, len(keys))
+	for i, key := range keys {
+		k[i] = attribute.Key(key)
+	}
+
+	return &Vec[T]{
+		inst:    inst,
+		keys:    k,
+		bind:    bind,
+		cfg:     cfg,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// tracked reports whether entry use needs to be tracked.
+func (v *Vec[T]) tracked() bool {
+	return v.cfg.maxEntries > 0 || v.cfg.idleTimeout > 0
+}
+
+// Get returns the instrument bound to the attribute keys of v with values. The
+// values need to be in the same order as the keys of v. An error is returned
+// if the number of values does not match the number of keys.
+func (v *Vec[T]) Get(values ...string) (T, error) {
+	if len(values) != len(v.keys) {
+		var zero T
+		return zero, fmt.Errorf("bind: %d values passed for %d keys", len(values), len(v.keys))
+	}
+
+	var buf [128]byte
+	key := appendKey(buf[:0], values)
+
+	if v.tracked() {
+		return v.use(key, values), nil
+	}
+
+	v.mu.RLock()
+	elem, ok := v.entries[string(key)]
+	v.mu.RUnlock()
+	if ok {
+		return elem.Value.(*vecEntry[T]).inst, nil
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if elem, ok := v.entries[string(key)]; ok {
+		// Added concurrently.
+		return elem.Value.(*vecEntry[T]).inst, nil
+	}
+	return v.add(string(key), values, time.Time{}).inst, nil
+}
+
+// WithLabelValues returns the instrument bound to the attribute keys of v
+// with values. It panics if the number of values does not match the number of
+// keys (see [Vec.Get]).
+func (v *Vec[T]) WithLabelValues(values ...string) T {
+	inst, err := v.Get(values...)
+	if err != nil {
+		panic(err)
+	}
+	return inst
+}
+
+// use returns the instrument bound to values and marks it as the most
+// recently used. Idle entries are evicted first.
+func (v *Vec[T]) use(key []byte, values []string) T {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := time.Now()
+	v.sweep(now)
+
+	if elem, ok := v.entries[string(key)]; ok {
+		e := elem.Value.(*vecEntry[T])
+		e.lastUsed = now
+		v.lru.MoveToFront(elem)
+		return e.inst
+	}
+
+	if n := v.cfg.maxEntries; n > 0 && v.lru.Len() >= n {
+		v.remove(v.lru.Back())
+	}
+	return v.add(string(key), values, now).inst
+}
+
+// add creates and caches the instrument bound to values. The write lock of v
+// needs to be held.
+func (v *Vec[T]) add(key string, values []string, now time.Time) *vecEntry[T] {
+	attrs := make([]attribute.KeyValue, len(values))
+	for i, val := range values {
+		attrs[i] = v.keys[i].String(val)
+	}
+
+	e := &vecEntry[T]{key: key, inst: v.bind(v.inst, attrs...), lastUsed: now}
+	v.entries[key] = v.lru.PushFront(e)
+	return e
+}
+
+// remove removes the entry of elem. The write lock of v needs to be held.
+func (v *Vec[T]) remove(elem *list.Element) {
+	v.lru.Remove(elem)
+	delete(v.entries, elem.Value.(*vecEntry[T]).key)
+}
+
+// sweep removes all entries idle since before now minus the idle timeout.
+// Only the idle entries are visited. The write lock of v needs to be held.
+func (v *Vec[T]) sweep(now time.Time) {
+	d := v.cfg.idleTimeout
+	if d <= 0 {
+		return
+	}
+	cutoff := now.Add(-d)
+	for elem := v.lru.Back(); elem != nil; elem = v.lru.Back() {
+		if !elem.Value.(*vecEntry[T]).lastUsed.Before(cutoff) {
+			return
+		}
+		v.remove(elem)
+	}
+}
+
+// Delete removes the cached instrument bound to values. It returns true if
+// an instrument was removed.
+//
+// Instruments already returned by v remain usable after they are removed.
+func (v *Vec[T]) Delete(values ...string) bool {
+	if len(values) != len(v.keys) {
+		return false
+	}
+	key := string(appendKey(nil, values))
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	elem, ok := v.entries[key]
+	if ok {
+		v.remove(elem)
+	}
+	return ok
+}
+
+// Reset removes all cached instruments.
+func (v *Vec[T]) Reset() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	clear(v.entries)
+	v.lru.Init()
+}
+
+// Len returns the number of cached instruments. Idle instruments are evicted
+// first (see [WithIdleTimeout]).
+func (v *Vec[T]) Len() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.sweep(time.Now())
+	return len(v.entries)
+}
+
+// appendKey appends an unambiguous encoding of values to buf.
+func appendKey(buf []byte, values []string) []byte {
+	for _, val := range values {
+		buf = binary.AppendUvarint(buf, uint64(len(val)))
+		buf = append(buf, val...)
+	}
+	return buf
+}
+
+type (
+	// Int64CounterVec is a family of [metric.Int64Counter] bound to the same
+	// attribute keys.
+	Int64CounterVec = Vec[metric.Int64Counter]
+	// Int64UpDownCounterVec is a family of [metric.Int64UpDownCounter] bound
+	// to the same attribute keys.
+	Int64UpDownCounterVec = Vec[metric.Int64UpDownCounter]
+	// Int64HistogramVec is a family of [metric.Int64Histogram] bound to the
+	// same attribute keys.
+	Int64HistogramVec = Vec[metric.Int64Histogram]
+	// Int64GaugeVec is a family of [metric.Int64Gauge] bound to the same
+	// attribute keys.
+	Int64GaugeVec = Vec[metric.Int64Gauge]
+	// Float64CounterVec is a family of [metric.Float64Counter] bound to the
+	// same attribute keys.
+	Float64CounterVec = Vec[metric.Float64Counter]
+	// Float64UpDownCounterVec is a family of [metric.Float64UpDownCounter]
+	// bound to the same attribute keys.
+	Float64UpDownCounterVec = Vec[metric.Float64UpDownCounter]
+	// Float64HistogramVec is a family of [metric.Float64Histogram] bound to
+	// the same attribute keys.
+	Float64HistogramVec = Vec[metric.Float64Histogram]
+	// Float64GaugeVec is a family of [metric.Float64Gauge] bound to the same
+	// attribute keys.
+	Float64GaugeVec = Vec[metric.Float64Gauge]
+)
+
+// NewInt64CounterVec returns a new [Int64CounterVec] that binds inst to
+// keys (see [Int64Counter]).
+func NewInt64CounterVec(inst metric.Int64Counter, keys []string, opts ...VecOption) *Int64CounterVec {
+	return newVec(inst, Int64Counter, keys, opts)
+}
+
+// NewInt64UpDownCounterVec returns a new [Int64UpDownCounterVec] that binds
+// inst to keys (see [Int64UpDownCounter]).
+func NewInt64UpDownCounterVec(inst metric.Int64UpDownCounter, keys []string, opts ...VecOption) *Int64UpDownCounterVec {
+	return newVec(inst, Int64UpDownCounter, keys, opts)
+}
+
+// NewInt64HistogramVec returns a new [Int64HistogramVec] that binds inst to
+// keys (see [Int64Histogram]).
+func NewInt64HistogramVec(inst metric.Int64Histogram, keys []string, opts ...VecOption) *Int64HistogramVec {
+	return newVec(inst, Int64Histogram, keys, opts)
+}
+
+// NewInt64GaugeVec returns a new [Int64GaugeVec] that binds inst to keys
+// (see [Int64Gauge]).
+func NewInt64GaugeVec(inst metric.Int64Gauge, keys []string, opts ...VecOption) *Int64GaugeVec {
+	return newVec(inst, Int64Gauge, keys, opts)
+}
+
+// NewFloat64CounterVec returns a new [Float64CounterVec] that binds inst to
+// keys (see [Float64Counter]).
+func NewFloat64CounterVec(inst metric.Float64Counter, keys []string, opts ...VecOption) *Float64CounterVec {
+	return newVec(inst, Float64Counter, keys, opts)
+}
+
+// NewFloat64UpDownCounterVec returns a new [Float64UpDownCounterVec] that
+// binds inst to keys (see [Float64UpDownCounter]).
+func NewFloat64UpDownCounterVec(inst metric.Float64UpDownCounter, keys []string, opts ...VecOption) *Float64UpDownCounterVec {
+	return newVec(inst, Float64UpDownCounter, keys, opts)
+}
+
+// NewFloat64HistogramVec returns a new [Float64HistogramVec] that binds inst
+// to keys (see [Float64Histogram]).
+func NewFloat64HistogramVec(inst metric.Float64Histogram, keys []string, opts ...VecOption) *Float64HistogramVec {
+	return newVec(inst, Float64Histogram, keys, opts)
+}
+
+// NewFloat64GaugeVec returns a new [Float64GaugeVec] that binds inst to keys
+// (see [Float64Gauge]).
+func NewFloat64GaugeVec(inst metric.Float64Gauge, keys []string, opts ...VecOption) *Float64GaugeVec {
+	return newVec(inst, Float64Gauge, keys, opts)
+}
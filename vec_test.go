@@ -0,0 +1,158 @@
+package bind_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+var (
+	methodGET  = attribute.String("method", "GET")
+	routeUsers = attribute.String("route", "/users")
+)
+
+func testVec[T any, N any](mock Mock[T, N], newVec func(T, []string, ...bind.VecOption) *bind.Vec[T], m Measure[T, N], val N) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		vec := newVec(mock.Instrument(), []string{"method", "route"})
+
+		inst := vec.WithLabelValues("GET", "/users")
+		m(inst, context.Background(), val, []attribute.KeyValue{userID})
+		_, got := mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{methodGET, routeUsers, userID}, got)
+
+		again, err := vec.Get("GET", "/users")
+		require.NoError(t, err)
+		assert.Equal(t, inst, again, "cached instrument")
+		assert.Equal(t, 1, vec.Len())
+	}
+}
+
+func TestVec(t *testing.T) {
+	t.Run("Int64Counter", testVec(&mockInt64Counter{}, bind.NewInt64CounterVec, measInt64Counter, 1))
+	t.Run("Int64UpDownCounter", testVec(&mockInt64UpDownCounter{}, bind.NewInt64UpDownCounterVec, measInt64UpDownCounter, 1))
+	t.Run("Int64Histogram", testVec(&mockInt64Histogram{}, bind.NewInt64HistogramVec, measInt64Histogram, 1))
+	t.Run("Int64Gauge", testVec(&mockInt64Gauge{}, bind.NewInt64GaugeVec, measInt64Gauge, 1))
+	t.Run("Float64Counter", testVec(&mockFloat64Counter{}, bind.NewFloat64CounterVec, measFloat64Counter, 1))
+	t.Run("Float64UpDownCounter", testVec(&mockFloat64UpDownCounter{}, bind.NewFloat64UpDownCounterVec, measFloat64UpDownCounter, 1))
+	t.Run("Float64Histogram", testVec(&mockFloat64Histogram{}, bind.NewFloat64HistogramVec, measFloat64Histogram, 1))
+	t.Run("Float64Gauge", testVec(&mockFloat64Gauge{}, bind.NewFloat64GaugeVec, measFloat64Gauge, 1))
+}
+
+func TestVecValueCount(t *testing.T) {
+	vec := bind.NewFloat64CounterVec(&mockFloat64Counter{}, []string{"method", "route"})
+
+	_, err := vec.Get("GET")
+	require.Error(t, err)
+	assert.Panics(t, func() { vec.WithLabelValues("GET", "/users", "extra") })
+	assert.False(t, vec.Delete("GET"))
+}
+
+func TestVecUnambiguous(t *testing.T) {
+	vec := bind.NewFloat64CounterVec(&mockFloat64Counter{}, []string{"a", "b"})
+
+	_ = vec.WithLabelValues("ab", "c")
+	_ = vec.WithLabelValues("a", "bc")
+	assert.Equal(t, 2, vec.Len())
+}
+
+func TestVecDeleteReset(t *testing.T) {
+	vec := bind.NewInt64CounterVec(&mockInt64Counter{}, []string{"method"})
+
+	get := vec.WithLabelValues("GET")
+	_ = vec.WithLabelValues("POST")
+	require.Equal(t, 2, vec.Len())
+
+	assert.True(t, vec.Delete("GET"))
+	assert.False(t, vec.Delete("GET"), "already deleted")
+	assert.Equal(t, 1, vec.Len())
+
+	// Deleted instruments remain usable.
+	get.Add(context.Background(), 1)
+
+	vec.Reset()
+	assert.Equal(t, 0, vec.Len())
+}
+
+func TestVecMaxEntries(t *testing.T) {
+	vec := bind.NewInt64CounterVec(&mockInt64Counter{}, []string{"method"}, bind.WithMaxEntries(2))
+
+	get := vec.WithLabelValues("GET")
+	_ = vec.WithLabelValues("POST")
+	_ = vec.WithLabelValues("GET") // Mark GET as recently used.
+	_ = vec.WithLabelValues("PUT")
+
+	assert.Equal(t, 2, vec.Len())
+	assert.False(t, vec.Delete("POST"), "least recently used should be evicted")
+	assert.Equal(t, get, vec.WithLabelValues("GET"), "recently used should be kept")
+}
+
+func TestVecIdleTimeout(t *testing.T) {
+	const timeout = 20 * time.Millisecond
+	vec := bind.NewInt64CounterVec(&mockInt64Counter{}, []string{"method"}, bind.WithIdleTimeout(timeout))
+
+	_ = vec.WithLabelValues("GET")
+	time.Sleep(2 * timeout)
+	_ = vec.WithLabelValues("POST")
+
+	assert.Equal(t, 1, vec.Len(), "idle entry should be evicted")
+	assert.False(t, vec.Delete("GET"), "idle entry should be evicted")
+}
+
+func TestVecIdleTimeoutOnHit(t *testing.T) {
+	const timeout = 20 * time.Millisecond
+	vec := bind.NewInt64CounterVec(&mockInt64Counter{}, []string{"method"}, bind.WithIdleTimeout(timeout))
+
+	_ = vec.WithLabelValues("GET")
+	post := vec.WithLabelValues("POST")
+	time.Sleep(2 * timeout)
+	assert.Equal(t, post, vec.WithLabelValues("POST"), "cached instrument")
+
+	assert.False(t, vec.Delete("GET"), "idle entry should be evicted without a miss")
+	assert.Equal(t, 1, vec.Len())
+}
+
+func TestVecConcurrentSafe(t *testing.T) {
+	vec := bind.NewFloat64HistogramVec(noop.Float64Histogram{}, []string{"n"}, bind.WithMaxEntries(5))
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Go(func() {
+			vals := []string{"a", "b", "c", "d", "e", "f"}
+			inst := vec.WithLabelValues(vals[i%len(vals)])
+			inst.Record(context.Background(), 1)
+			if i%7 == 0 {
+				vec.Reset()
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func TestVecGetAllocs(t *testing.T) {
+	vec := bind.NewFloat64CounterVec(noop.Float64Counter{}, []string{"method", "route"})
+	_ = vec.WithLabelValues("GET", "/users")
+
+	allocs := testing.AllocsPerRun(100, func() { _ = vec.WithLabelValues("GET", "/users") })
+	assert.Zero(t, allocs, "cached instrument lookup should not allocate")
+}
+
+func BenchmarkVec(b *testing.B) {
+	vec := bind.NewFloat64CounterVec(noop.Float64Counter{}, []string{"method", "route"})
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			vec.WithLabelValues("GET", "/users").Add(ctx, 1)
+		}
+	})
+}
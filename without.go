@@ -0,0 +1,74 @@
+package bind
+
+import (
+	"reflect"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Without returns inst without the bound attributes with keys. Keys that are
+// not bound are ignored. If inst is not bound, it is returned unchanged.
+//
+// If no bound attributes remain and inst is not configured with options (see
+// [Configure]), the underlying instrument is returned, the same instrument
+// [Unwrap] returns. Otherwise, a bound instrument with the remaining
+// attributes and the same options is returned. It shares any cardinality
+// limit (see [Limit]) with inst, but not the cache of merged attribute sets
+// (see [WithCache]).
+//
+// T needs to be one of the following types, otherwise Without panics:
+//
+//   - [metric.Int64Counter]
+//   - [metric.Int64UpDownCounter]
+//   - [metric.Int64Histogram]
+//   - [metric.Int64Gauge]
+//   - [metric.Float64Counter]
+//   - [metric.Float64UpDownCounter]
+//   - [metric.Float64Histogram]
+//   - [metric.Float64Gauge]
+//   - [metric.Meter]
+//   - [metric.MeterProvider]
+func Without[T any](inst T, keys ...attribute.Key) T {
+	if len(keys) == 0 {
+		return inst
+	}
+
+	switch p := any(&inst).(type) {
+	case *metric.Int64Counter:
+		*p = withoutInt64Counter(*p, keys)
+	case *metric.Int64UpDownCounter:
+		*p = withoutInt64UpDownCounter(*p, keys)
+	case *metric.Int64Histogram:
+		*p = withoutInt64Histogram(*p, keys)
+	case *metric.Int64Gauge:
+		*p = withoutInt64Gauge(*p, keys)
+	case *metric.Float64Counter:
+		*p = withoutFloat64Counter(*p, keys)
+	case *metric.Float64UpDownCounter:
+		*p = withoutFloat64UpDownCounter(*p, keys)
+	case *metric.Float64Histogram:
+		*p = withoutFloat64Histogram(*p, keys)
+	case *metric.Float64Gauge:
+		*p = withoutFloat64Gauge(*p, keys)
+	case *metric.Meter:
+		*p = withoutMeter(*p, keys)
+	case *metric.MeterProvider:
+		*p = withoutMeterProvider(*p, keys)
+	default:
+		panic("bind: unsupported type " + reflect.TypeFor[T]().String())
+	}
+	return inst
+}
+
+// without returns a copy of attrs without the attributes with keys.
+func without(attrs []attribute.KeyValue, keys []attribute.Key) []attribute.KeyValue {
+	out := make([]attribute.KeyValue, 0, len(attrs))
+	for _, kv := range attrs {
+		if !slices.Contains(keys, kv.Key) {
+			out = append(out, kv)
+		}
+	}
+	return out
+}
@@ -0,0 +1,103 @@
+package bind_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MrAlias/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func testWithout[T any, N any](mock Mock[T, N], b Binder[T], m Measure[T, N], val N) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		bound := b(b(mock.Instrument(), userAlice, userID), adminTrue)
+
+		inst := bind.Without(bound, "id", "unknown")
+		m(inst, context.Background(), val, nil)
+		_, got := mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, adminTrue}, got, "without id")
+
+		m(bound, context.Background(), val, nil)
+		_, got = mock.Recorded()
+		assert.ElementsMatch(t, []attribute.KeyValue{userAlice, userID, adminTrue}, got, "original unchanged")
+
+		inst = bind.Without(inst, "user", "admin")
+		assert.Equal(t, mock.Instrument(), inst, "no remaining attributes should return the underlying instrument")
+
+		assert.Equal(t, mock.Instrument(), bind.Without(mock.Instrument(), "user"), "unbound instrument")
+	}
+}
+
+func TestWithout(t *testing.T) {
+	t.Run("Int64Counter", testWithout(&mockInt64Counter{}, bind.Int64Counter, measInt64Counter, 1))
+	t.Run("Int64UpDownCounter", testWithout(&mockInt64UpDownCounter{}, bind.Int64UpDownCounter, measInt64UpDownCounter, 1))
+	t.Run("Int64Histogram", testWithout(&mockInt64Histogram{}, bind.Int64Histogram, measInt64Histogram, 1))
+	t.Run("Int64Gauge", testWithout(&mockInt64Gauge{}, bind.Int64Gauge, measInt64Gauge, 1))
+	t.Run("Float64Counter", testWithout(&mockFloat64Counter{}, bind.Float64Counter, measFloat64Counter, 1))
+	t.Run("Float64UpDownCounter", testWithout(&mockFloat64UpDownCounter{}, bind.Float64UpDownCounter, measFloat64UpDownCounter, 1))
+	t.Run("Float64Histogram", testWithout(&mockFloat64Histogram{}, bind.Float64Histogram, measFloat64Histogram, 1))
+	t.Run("Float64Gauge", testWithout(&mockFloat64Gauge{}, bind.Float64Gauge, measFloat64Gauge, 1))
+}
+
+func TestWithoutConfigured(t *testing.T) {
+	mock := &mockFloat64Counter{}
+	inst := bind.Configure(bind.Float64Counter(mock, userAlice), bind.WithContextAttributes())
+
+	inst = bind.Without(inst, "user")
+	assert.NotEqual(t, mock, inst, "configured instrument should remain bound")
+
+	inst.Add(bind.ContextWithAttributes(context.Background(), userID), 1)
+	_, got := mock.Recorded()
+	assert.Equal(t, []attribute.KeyValue{userID}, got, "options should be retained")
+}
+
+func TestWithoutSharesLimit(t *testing.T) {
+	mock := &mockInt64Counter{}
+	inst := bind.Limit(bind.Int64Counter(mock, userAlice, userID), 1)
+	child := bind.Without(inst, "id")
+
+	ctx := context.Background()
+	inst.Add(ctx, 1)
+	child.Add(ctx, 1)
+	_, got := mock.Recorded()
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, overflow}, got, "limit shared with inst")
+
+	stats, ok := bind.ReadLimitStats(inst)
+	require.True(t, ok)
+	assert.Equal(t, bind.LimitStats{Sets: 1, DroppedSets: 1, DroppedMeasurements: 1}, stats)
+}
+
+func TestWithoutMeter(t *testing.T) {
+	mock := &mockMeter{}
+	m := bind.Meter(mock, userAlice, userID)
+
+	m = bind.Without(m, "id")
+	assert.Equal(t, []attribute.KeyValue{userAlice}, meterAttrs(t, m))
+
+	assert.Same(t, mock, bind.Without(m, "user"), "no remaining attributes should return the underlying meter")
+
+	cfg := bind.Configure(m, bind.WithContextAttributes())
+	assert.NotSame(t, mock, bind.Without(cfg, "user"), "configured meter should remain bound")
+}
+
+func TestWithoutMeterProvider(t *testing.T) {
+	mock := &mockMeterProvider{}
+	mp := bind.MeterProvider(mock, userAlice, userID)
+	mp = bind.ScopedMeterProvider(mp, "db/*", attribute.String("db", "postgres"), userID)
+
+	mp = bind.Without(mp, "id")
+	assert.Equal(t, []attribute.KeyValue{userAlice}, meterAttrs(t, mp.Meter("http")))
+	assert.ElementsMatch(t, []attribute.KeyValue{userAlice, attribute.String("db", "postgres")}, meterAttrs(t, mp.Meter("db/sql")))
+
+	assert.Same(t, mock, bind.Without(mp, "user", "db"), "no remaining attributes should return the underlying provider")
+}
+
+func TestWithoutUnsupported(t *testing.T) {
+	assert.PanicsWithValue(t, "bind: unsupported type string", func() { bind.Without("inst", "user") })
+	assert.NotPanics(t, func() { bind.Without[metric.Meter](nil) }, "no keys")
+}